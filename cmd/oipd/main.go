@@ -16,7 +16,7 @@ import (
 	"github.com/oipwg/oip/flo"
 	"github.com/oipwg/oip/httpapi"
 	_ "github.com/oipwg/oip/modules"
-	"github.com/oipwg/oip/modules/oip5/templates"
+	"github.com/oipwg/oip/modules/module"
 	"github.com/oipwg/oip/sync"
 	"github.com/oipwg/oip/version"
 )
@@ -73,6 +73,13 @@ func main() {
 		return
 	}
 
+	err = module.Load()
+	if err != nil {
+		log.Error("Loading modules failed", logger.Attrs{"err": err})
+		shutdown(err)
+		return
+	}
+
 	apiEnabled := viper.GetBool("oip.api.enabled")
 	if apiEnabled {
 		log.Info("starting http api")
@@ -97,9 +104,9 @@ func main() {
 
 	config.PostConfig(rootContext)
 
-	err = templates.LoadTemplatesFromES(rootContext)
+	err = module.Init(rootContext)
 	if err != nil {
-		log.Error("Loading OIP5 record templates failed", logger.Attrs{"err": err})
+		log.Error("Initializing modules failed", logger.Attrs{"err": err})
 		shutdown(err)
 		return
 	}
@@ -111,7 +118,7 @@ func main() {
 		return
 	}
 
-	sync.IsInitialSync = false
+	sync.IsInitialSync.Set(false)
 	datastore.AutoBulk.BeginTimedCommits(5 * time.Second)
 
 	err = flo.BeginNotifyBlocks()
@@ -146,4 +153,5 @@ func main() {
 
 func shutdown(err error) {
 	log.Error("Shutting down...", err)
+	module.Shutdown(context.Background())
 }
@@ -68,6 +68,25 @@ func init() {
 			panic(err)
 		}
 	}
+
+	migrateDeprecated()
+}
+
+// deprecatedKeys maps keys which have moved to their current key
+var deprecatedKeys = map[string]string{
+	"oip.oip5.publisherCacheDepth": "oip.modules.oip5.publisherCacheDepth",
+	"oip.oip5.recordCacheDepth":    "oip.modules.oip5.recordCacheDepth",
+}
+
+// migrateDeprecated copies the value of each deprecated key still set to its current key
+func migrateDeprecated() {
+	for old, key := range deprecatedKeys {
+		if !viper.IsSet(old) {
+			continue
+		}
+		log.Error("deprecated config key, use the new key instead", logger.Attrs{"key": old, "newKey": key})
+		viper.Set(key, viper.Get(old))
+	}
 }
 
 func loadDefaults() {
@@ -96,10 +115,20 @@ func loadDefaults() {
 	// HttpApi defaults
 	viper.SetDefault("oip.api.listen", "127.0.0.1:1606")
 	viper.SetDefault("oip.api.enabled", false)
-
-	// oip5 defaults
-	viper.SetDefault("oip.oip5.publisherCacheDepth", 1000)
-	viper.SetDefault("oip.oip5.recordCacheDepth", 10000)
+	viper.SetDefault("oip.api.cache.confirmations", 10)
+	viper.SetDefault("oip.api.cache.shortMaxAge", "10s")
+	viper.SetDefault("oip.api.cache.pinnedMaxAge", "720h")
+	viper.SetDefault("oip.api.health.maxSyncLag", 10)
+	viper.SetDefault("oip.api.health.timeout", "5s")
+
+	// oip5 module defaults
+	viper.SetDefault("oip.modules.oip5.publisherCacheDepth", 1000)
+	viper.SetDefault("oip.modules.oip5.recordCacheDepth", 10000)
+	viper.SetDefault("oip.modules.oip5.recordValidation", "flag")
+
+	// script module defaults
+	viper.SetDefault("oip.modules.script.dir", "scripts")
+	viper.SetDefault("oip.modules.script.maxSteps", 1000000)
 }
 
 func IsTestnet() bool {
@@ -2,6 +2,8 @@ package config
 
 import (
 	"testing"
+
+	"github.com/spf13/viper"
 )
 
 func TestSetTestnet(t *testing.T) {
@@ -21,3 +23,16 @@ func TestSetTestnet(t *testing.T) {
 	// restore
 	SetTestnet(testnet)
 }
+
+func TestMigrateDeprecated(t *testing.T) {
+	defer viper.Set("oip.modules.oip5.recordCacheDepth", viper.GetInt("oip.modules.oip5.recordCacheDepth"))
+
+	viper.Set("oip.oip5.recordCacheDepth", 42)
+	migrateDeprecated()
+	if d := viper.GetInt("oip.modules.oip5.recordCacheDepth"); d != 42 {
+		t.Errorf("expected 42, received %d", d)
+	}
+	if d := viper.GetInt("oip.modules.oip5.publisherCacheDepth"); d != 1000 {
+		t.Errorf("unset deprecated key replaced the default, received %d", d)
+	}
+}
@@ -2,7 +2,10 @@ package datastore
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/azer/logger"
@@ -11,12 +14,14 @@ import (
 	"gopkg.in/olivere/elastic.v6"
 
 	"github.com/oipwg/oip/events"
+	"github.com/oipwg/oip/trace"
 )
 
 func BeginBulkIndexer() BulkIndexer {
 	bi := BulkIndexer{
-		bulk: client.Bulk(),
-		m:    &sync.Mutex{},
+		bulk:           client.Bulk(),
+		m:              &sync.Mutex{},
+		timedCommitEnd: make(chan struct{}),
 	}
 	bi.bulk.Refresh("true")
 
@@ -24,33 +29,48 @@ func BeginBulkIndexer() BulkIndexer {
 }
 
 type BulkIndexer struct {
+	// unix nanoseconds of the last timed commit loop iteration, accessed atomically
+	lastTimedCommit int64
+	// time.Duration between timed commits, accessed atomically
+	timedCommitRate int64
+	// 1 while the timed commit loop runs, accessed atomically
+	timedCommitRunning int32
 	bulk               *elastic.BulkService
 	m                  *sync.Mutex
-	timedCommitRate    time.Duration
-	timedCommitRunning bool
 	timedCommitEnd     chan struct{}
 }
 
 func (bi *BulkIndexer) BeginTimedCommits(rate time.Duration) {
-	bi.timedCommitRate = rate
-	if bi.timedCommitRunning {
+	atomic.StoreInt64(&bi.timedCommitRate, int64(rate))
+	if !atomic.CompareAndSwapInt32(&bi.timedCommitRunning, 0, 1) {
 		return
 	}
 	go bi.timedCommit()
 }
 
+// TimedCommitRate returns the duration between timed commits
+func (bi *BulkIndexer) TimedCommitRate() time.Duration {
+	return time.Duration(atomic.LoadInt64(&bi.timedCommitRate))
+}
+
 func (bi *BulkIndexer) Commit() {
 	bi.quickCommit()
 }
 
 func (bi *BulkIndexer) timedCommit() {
+	defer func() {
+		atomic.StoreInt64(&bi.lastTimedCommit, 0)
+		atomic.StoreInt32(&bi.timedCommitRunning, 0)
+	}()
+	atomic.StoreInt64(&bi.lastTimedCommit, time.Now().UnixNano())
 	for {
 		select {
 		case <-bi.timedCommitEnd:
 			bi.quickCommit()
 			return
-		case <-time.After(bi.timedCommitRate):
+		case <-time.After(bi.TimedCommitRate()):
 			bi.quickCommit()
+			atomic.StoreInt64(&bi.lastTimedCommit, time.Now().UnixNano())
 		}
 	}
 }
@@ -105,15 +125,38 @@ func (bi *BulkIndexer) quickCommit() {
 	}
 }
 
+// LastTimedCommit returns when the timed commit loop last completed an iteration
+// The zero time is returned if timed commits are not running
+func (bi *BulkIndexer) LastTimedCommit() time.Time {
+	n := atomic.LoadInt64(&bi.lastTimedCommit)
+	if n == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, n)
+}
+
+// EndTimedCommit stops the timed commit loop after a final commit
 func (bi *BulkIndexer) EndTimedCommit() {
+	if atomic.LoadInt32(&bi.timedCommitRunning) == 0 {
+		return
+	}
 	bi.timedCommitEnd <- struct{}{}
 }
 
 func (bi *BulkIndexer) Do(ctx context.Context) (*elastic.BulkResponse, error) {
+	bulkFlushActions.Observe(float64(bi.NumberOfActions()))
+	bulkFlushBytes.Observe(float64(bi.EstimateSizeInBytes()))
+	start := time.Now()
 	br, err := bi.bulk.Do(ctx)
-	if err == nil {
-		events.Publish("datastore:commit")
+	bulkFlushDuration.Observe(time.Since(start).Seconds())
+	if err != nil {
+		bulkFlushFailures.WithLabelValues("request").Inc()
+		return br, err
+	}
+	if br.Errors {
+		bulkFlushFailures.WithLabelValues("item").Add(float64(len(br.Failed())))
 	}
+	events.Publish("datastore:commit")
 	return br, err
 }
 
@@ -175,6 +218,45 @@ func (bi *BulkIndexer) Add(bir ...elastic.BulkableRequest) {
 	}
 }
 
+// AddFor adds requests made while processing tx
+// If tx is being traced the requests are recorded on the trace rather than executed
+func (bi *BulkIndexer) AddFor(tx *TransactionData, bir ...elastic.BulkableRequest) {
+	if t := tx.GetTrace(); t != nil {
+		for _, r := range bir {
+			t.Add(writeStep(r))
+		}
+		return
+	}
+	bi.Add(bir...)
+}
+
+func writeStep(r elastic.BulkableRequest) *trace.Step {
+	step := &trace.Step{Kind: trace.KindWrite}
+	lines, err := r.Source()
+	if err != nil || len(lines) == 0 {
+		step.Error = fmt.Sprint("unable to serialize request: ", err)
+		return step
+	}
+
+	// the first line is the action and its metadata, the second the document if any
+	var action map[string]struct {
+		Index string `json:"_index"`
+		Id    string `json:"_id"`
+	}
+	if err := json.Unmarshal([]byte(lines[0]), &action); err != nil {
+		step.Error = err.Error()
+	}
+	for name, meta := range action {
+		step.Action = name
+		step.Index = meta.Index
+		step.Id = meta.Id
+	}
+	if len(lines) > 1 {
+		step.Doc = json.RawMessage(lines[1])
+	}
+	return step
+}
+
 type BulkIndexerResponse struct {
 	*elastic.BulkResponse
 	EstimatedSize int64
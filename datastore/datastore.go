@@ -96,11 +96,16 @@ func getHttpClient() (*http.Client, error) {
 }
 
 func RegisterMapping(index, fileName string) {
-	index = Index(index) // apply proper prefix
 	mapping, err := mapBox.FindString(fileName)
 	if err != nil {
-		panic(fmt.Sprintf("Unable to find mapping %s for index %s", fileName, index))
+		panic(fmt.Sprintf("Unable to find mapping %s for index %s", fileName, Index(index)))
 	}
+	RegisterMappingJSON(index, mapping)
+}
+
+// RegisterMappingJSON registers a mapping not bundled with the binary, ex: declared by a script
+func RegisterMappingJSON(index, mapping string) {
+	index = Index(index) // apply proper prefix
 	mappings[index] = mapping
 	if client != nil {
 		err := createIndex(context.TODO(), index, mapping)
@@ -133,6 +138,13 @@ func Client() *elastic.Client {
 	return client
 }
 
+// SetClient replaces the elasticsearch client and AutoBulk, tests use it to
+// connect to a datastoretest.Server
+func SetClient(c *elastic.Client) {
+	client = c
+	AutoBulk = BeginBulkIndexer()
+}
+
 func Index(index string) string {
 	if config.IsTestnet() {
 		if strings.HasPrefix(index, "testnet-") {
@@ -0,0 +1,14 @@
+package datastoretest
+
+import "gopkg.in/olivere/elastic.v6"
+
+// Client returns an elasticsearch client connected to the server; tests
+// install it with datastore.SetClient
+func (s *Server) Client() *elastic.Client {
+	c, err := elastic.NewClient(elastic.SetURL(s.URL()), elastic.SetSniff(false),
+		elastic.SetHealthcheck(false))
+	if err != nil {
+		panic("datastoretest: unable to create client: " + err.Error())
+	}
+	return c
+}
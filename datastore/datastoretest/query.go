@@ -0,0 +1,779 @@
+package datastoretest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// query is a decoded search query; only the query types used by oipd are supported
+type query interface {
+	matches(index string, d *document) bool
+}
+
+type matchAll struct{}
+
+type matchNone struct{}
+
+type termQuery struct {
+	field  string
+	values []interface{}
+}
+
+type prefixQuery struct {
+	field  string
+	prefix string
+}
+
+type matchQuery struct {
+	field string
+	text  string
+}
+
+type existsQuery struct {
+	field string
+}
+
+type idsQuery struct {
+	ids []string
+}
+
+type rangeQuery struct {
+	field        string
+	lower, upper interface{}
+	incLower     bool
+	incUpper     bool
+}
+
+type boolQuery struct {
+	must, filter, should, mustNot []query
+	minimumShouldMatch            int
+}
+
+func decodeQuery(raw json.RawMessage) (query, error) {
+	if len(bytes.TrimSpace(raw)) == 0 || string(bytes.TrimSpace(raw)) == "null" {
+		return matchAll{}, nil
+	}
+
+	var q map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &q); err != nil {
+		return nil, fmt.Errorf("malformed query: %v", err)
+	}
+	if len(q) != 1 {
+		return nil, fmt.Errorf("query must have exactly one type, got %d", len(q))
+	}
+
+	for typ, body := range q {
+		switch typ {
+		case "match_all":
+			return matchAll{}, nil
+		case "match_none":
+			return matchNone{}, nil
+		case "term":
+			field, v, err := fieldQuery(body, "value")
+			if err != nil {
+				return nil, fmt.Errorf("[term] %v", err)
+			}
+			return termQuery{field: field, values: []interface{}{v}}, nil
+		case "terms":
+			field, v, err := fieldQuery(body, "")
+			if err != nil {
+				return nil, fmt.Errorf("[terms] %v", err)
+			}
+			values, ok := v.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("[terms] values of %s must be an array", field)
+			}
+			return termQuery{field: field, values: values}, nil
+		case "prefix":
+			field, v, err := fieldQuery(body, "value")
+			if err != nil {
+				return nil, fmt.Errorf("[prefix] %v", err)
+			}
+			return prefixQuery{field: field, prefix: canonical(v)}, nil
+		case "match":
+			field, v, err := fieldQuery(body, "query")
+			if err != nil {
+				return nil, fmt.Errorf("[match] %v", err)
+			}
+			return matchQuery{field: field, text: canonical(v)}, nil
+		case "exists":
+			var e struct {
+				Field string `json:"field"`
+			}
+			if err := json.Unmarshal(body, &e); err != nil || e.Field == "" {
+				return nil, fmt.Errorf("[exists] requires a field")
+			}
+			return existsQuery{field: e.Field}, nil
+		case "ids":
+			var e struct {
+				Values []string `json:"values"`
+			}
+			if err := json.Unmarshal(body, &e); err != nil {
+				return nil, fmt.Errorf("[ids] %v", err)
+			}
+			return idsQuery{ids: e.Values}, nil
+		case "range":
+			return decodeRange(body)
+		case "bool":
+			return decodeBool(body)
+		default:
+			return nil, fmt.Errorf("datastoretest does not support [%s] queries", typ)
+		}
+	}
+	panic("unreachable")
+}
+
+// fieldQuery decodes the {"field": value} or {"field": {"<key>": value}} forms
+// shared by the leaf queries
+func fieldQuery(body json.RawMessage, key string) (string, interface{}, error) {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(body, &m); err != nil {
+		return "", nil, err
+	}
+	delete(m, "boost")
+	delete(m, "_name")
+	if len(m) != 1 {
+		return "", nil, fmt.Errorf("expected a single field, got %d", len(m))
+	}
+	for field, raw := range m {
+		v, err := decodeValue(raw)
+		if err != nil {
+			return "", nil, err
+		}
+		if obj, ok := v.(map[string]interface{}); ok && key != "" {
+			inner, ok := obj[key]
+			if !ok {
+				return "", nil, fmt.Errorf("missing %s for %s", key, field)
+			}
+			v = inner
+		}
+		return field, v, nil
+	}
+	panic("unreachable")
+}
+
+func decodeRange(body json.RawMessage) (query, error) {
+	var m map[string]map[string]interface{}
+	d := json.NewDecoder(bytes.NewReader(body))
+	d.UseNumber()
+	if err := d.Decode(&m); err != nil {
+		return nil, fmt.Errorf("[range] %v", err)
+	}
+	if len(m) != 1 {
+		return nil, fmt.Errorf("[range] expected a single field, got %d", len(m))
+	}
+
+	for field, params := range m {
+		r := rangeQuery{field: field, incLower: true, incUpper: true}
+		for k, v := range params {
+			switch k {
+			case "from":
+				r.lower = v
+			case "to":
+				r.upper = v
+			case "include_lower":
+				r.incLower, _ = v.(bool)
+			case "include_upper":
+				r.incUpper, _ = v.(bool)
+			case "gt":
+				r.lower, r.incLower = v, false
+			case "gte":
+				r.lower, r.incLower = v, true
+			case "lt":
+				r.upper, r.incUpper = v, false
+			case "lte":
+				r.upper, r.incUpper = v, true
+			case "format", "boost", "_name":
+			default:
+				return nil, fmt.Errorf("[range] unsupported parameter %s", k)
+			}
+		}
+		var err error
+		if r.lower, err = dateMath(r.lower); err != nil {
+			return nil, err
+		}
+		if r.upper, err = dateMath(r.upper); err != nil {
+			return nil, err
+		}
+		return r, nil
+	}
+	panic("unreachable")
+}
+
+// dateMath resolves bounds such as "now-1w" to unix seconds, matching the
+// epoch_second format of the oipd mappings
+func dateMath(v interface{}) (interface{}, error) {
+	s, ok := v.(string)
+	if !ok || !strings.HasPrefix(s, "now") {
+		return v, nil
+	}
+
+	t := time.Now()
+	expr := s[3:]
+	for expr != "" {
+		sign := 1
+		switch expr[0] {
+		case '+':
+		case '-':
+			sign = -1
+		default:
+			return nil, fmt.Errorf("[range] unsupported date math %q", s)
+		}
+		i := 1
+		for i < len(expr) && expr[i] >= '0' && expr[i] <= '9' {
+			i++
+		}
+		if i == 1 || i == len(expr) {
+			return nil, fmt.Errorf("[range] malformed date math %q", s)
+		}
+		n, _ := strconv.Atoi(expr[1:i])
+		n *= sign
+		switch expr[i] {
+		case 'y':
+			t = t.AddDate(n, 0, 0)
+		case 'M':
+			t = t.AddDate(0, n, 0)
+		case 'w':
+			t = t.AddDate(0, 0, 7*n)
+		case 'd':
+			t = t.AddDate(0, 0, n)
+		case 'h', 'H':
+			t = t.Add(time.Duration(n) * time.Hour)
+		case 'm':
+			t = t.Add(time.Duration(n) * time.Minute)
+		case 's':
+			t = t.Add(time.Duration(n) * time.Second)
+		default:
+			return nil, fmt.Errorf("[range] unsupported date math unit in %q", s)
+		}
+		expr = expr[i+1:]
+	}
+	return json.Number(strconv.FormatInt(t.Unix(), 10)), nil
+}
+
+func decodeBool(body json.RawMessage) (query, error) {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(body, &m); err != nil {
+		return nil, fmt.Errorf("[bool] %v", err)
+	}
+
+	b := boolQuery{minimumShouldMatch: -1}
+	for k, raw := range m {
+		var clauses *[]query
+		switch k {
+		case "must":
+			clauses = &b.must
+		case "filter":
+			clauses = &b.filter
+		case "should":
+			clauses = &b.should
+		case "must_not":
+			clauses = &b.mustNot
+		case "minimum_should_match":
+			var v interface{}
+			_ = json.Unmarshal(raw, &v)
+			n, err := strconv.Atoi(strings.TrimSpace(fmt.Sprint(v)))
+			if err != nil {
+				return nil, fmt.Errorf("[bool] unsupported minimum_should_match %s", raw)
+			}
+			b.minimumShouldMatch = n
+			continue
+		case "boost", "_name", "adjust_pure_negative", "disable_coord":
+			continue
+		default:
+			return nil, fmt.Errorf("[bool] unsupported clause %s", k)
+		}
+
+		var list []json.RawMessage
+		if t := bytes.TrimSpace(raw); len(t) > 0 && t[0] == '[' {
+			if err := json.Unmarshal(raw, &list); err != nil {
+				return nil, fmt.Errorf("[bool] %v", err)
+			}
+		} else {
+			list = []json.RawMessage{raw}
+		}
+		for _, c := range list {
+			q, err := decodeQuery(c)
+			if err != nil {
+				return nil, err
+			}
+			*clauses = append(*clauses, q)
+		}
+	}
+
+	if b.minimumShouldMatch < 0 {
+		b.minimumShouldMatch = 0
+		if len(b.should) > 0 && len(b.must) == 0 && len(b.filter) == 0 {
+			b.minimumShouldMatch = 1
+		}
+	}
+	return b, nil
+}
+
+func (matchAll) matches(string, *document) bool {
+	return true
+}
+
+func (matchNone) matches(string, *document) bool {
+	return false
+}
+
+func (q termQuery) matches(index string, d *document) bool {
+	for _, v := range fieldValues(index, d, q.field) {
+		for _, want := range q.values {
+			if equal(v, want) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (q prefixQuery) matches(index string, d *document) bool {
+	for _, v := range fieldValues(index, d, q.field) {
+		if strings.HasPrefix(canonical(v), q.prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// matches any of the whitespace separated terms, ignoring case, as a standard
+// analyzer would for simple text
+func (q matchQuery) matches(index string, d *document) bool {
+	want := strings.Fields(strings.ToLower(q.text))
+	for _, v := range fieldValues(index, d, q.field) {
+		for _, term := range strings.Fields(strings.ToLower(canonical(v))) {
+			for _, w := range want {
+				if term == w {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func (q existsQuery) matches(index string, d *document) bool {
+	return len(fieldValues(index, d, q.field)) > 0
+}
+
+func (q idsQuery) matches(_ string, d *document) bool {
+	for _, id := range q.ids {
+		if id == d.id {
+			return true
+		}
+	}
+	return false
+}
+
+func (q rangeQuery) matches(index string, d *document) bool {
+	for _, v := range fieldValues(index, d, q.field) {
+		if q.lower != nil {
+			c := compare(v, q.lower)
+			if c < 0 || c == 0 && !q.incLower {
+				continue
+			}
+		}
+		if q.upper != nil {
+			c := compare(v, q.upper)
+			if c > 0 || c == 0 && !q.incUpper {
+				continue
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func (q boolQuery) matches(index string, d *document) bool {
+	for _, c := range q.must {
+		if !c.matches(index, d) {
+			return false
+		}
+	}
+	for _, c := range q.filter {
+		if !c.matches(index, d) {
+			return false
+		}
+	}
+	for _, c := range q.mustNot {
+		if c.matches(index, d) {
+			return false
+		}
+	}
+	matched := 0
+	for _, c := range q.should {
+		if c.matches(index, d) {
+			matched++
+		}
+	}
+	return matched >= q.minimumShouldMatch
+}
+
+// fieldValues returns the non-null values at the dotted path field, flattening
+// arrays as elasticsearch does
+func fieldValues(index string, d *document, field string) []interface{} {
+	switch field {
+	case "_id":
+		return []interface{}{d.id}
+	case "_index":
+		return []interface{}{index}
+	}
+
+	values := []interface{}{d.source}
+	for _, key := range strings.Split(field, ".") {
+		var next []interface{}
+		for _, v := range values {
+			if m, ok := v.(map[string]interface{}); ok {
+				if child, ok := m[key]; ok {
+					next = appendFlat(next, child)
+				}
+			}
+		}
+		values = next
+	}
+	return values
+}
+
+func appendFlat(values []interface{}, v interface{}) []interface{} {
+	switch v := v.(type) {
+	case nil:
+		return values
+	case []interface{}:
+		for _, e := range v {
+			values = appendFlat(values, e)
+		}
+		return values
+	default:
+		return append(values, v)
+	}
+}
+
+// canonical returns the string form of a leaf value
+func canonical(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case json.Number:
+		if f, err := v.Float64(); err == nil {
+			return strconv.FormatFloat(f, 'f', -1, 64)
+		}
+		return v.String()
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case nil:
+		return ""
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
+
+func number(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	case float64:
+		return v, true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func isNumber(v interface{}) bool {
+	switch v.(type) {
+	case json.Number, float64:
+		return true
+	}
+	return false
+}
+
+// equal compares a stored value to a queried one, coercing the query value to
+// a number when the stored value is numeric as a numeric field mapping would
+func equal(stored, want interface{}) bool {
+	if isNumber(stored) {
+		a, _ := number(stored)
+		b, ok := number(want)
+		return ok && a == b
+	}
+	return canonical(stored) == canonical(want)
+}
+
+// compare orders two values numerically when both are numbers, otherwise as strings
+func compare(a, b interface{}) int {
+	if fa, ok := number(a); ok && (isNumber(a) || isNumber(b)) {
+		if fb, ok := number(b); ok {
+			switch {
+			case fa < fb:
+				return -1
+			case fa > fb:
+				return 1
+			}
+			return 0
+		}
+	}
+	return strings.Compare(canonical(a), canonical(b))
+}
+
+type sortField struct {
+	field string
+	desc  bool
+}
+
+// value returns the sort value of h, nil when the field is missing
+func (sf sortField) value(h hit) interface{} {
+	switch sf.field {
+	case "_doc":
+		return h.doc.seq
+	case "_score":
+		return 1.0
+	}
+	values := fieldValues(h.index, h.doc, sf.field)
+	if len(values) == 0 {
+		return nil
+	}
+	best := values[0]
+	for _, v := range values[1:] {
+		if c := compare(v, best); c < 0 && !sf.desc || c > 0 && sf.desc {
+			best = v
+		}
+	}
+	return best
+}
+
+func decodeSort(raw json.RawMessage) ([]sortField, error) {
+	if len(bytes.TrimSpace(raw)) == 0 {
+		return nil, nil
+	}
+	var list []json.RawMessage
+	if t := bytes.TrimSpace(raw); t[0] == '[' {
+		if err := json.Unmarshal(raw, &list); err != nil {
+			return nil, fmt.Errorf("malformed sort: %v", err)
+		}
+	} else {
+		list = []json.RawMessage{raw}
+	}
+
+	var sorts []sortField
+	for _, s := range list {
+		var field string
+		if err := json.Unmarshal(s, &field); err == nil {
+			sorts = append(sorts, sortField{field: field, desc: field == "_score"})
+			continue
+		}
+		var m map[string]json.RawMessage
+		if err := json.Unmarshal(s, &m); err != nil || len(m) != 1 {
+			return nil, fmt.Errorf("malformed sort %s", s)
+		}
+		for field, o := range m {
+			var order string
+			if err := json.Unmarshal(o, &order); err != nil {
+				var opts struct {
+					Order string `json:"order"`
+				}
+				if err := json.Unmarshal(o, &opts); err != nil {
+					return nil, fmt.Errorf("malformed sort %s", s)
+				}
+				order = opts.Order
+			}
+			sorts = append(sorts, sortField{field: field, desc: order == "desc"})
+		}
+	}
+	return sorts, nil
+}
+
+func sortHits(hits []hit, sorts []sortField) {
+	if len(sorts) == 0 {
+		return
+	}
+	sort.SliceStable(hits, func(i, j int) bool {
+		return compareSortValues(hits[i].sortValues(sorts), hits[j].sortValues(sorts), sorts) < 0
+	})
+}
+
+// compareSortValues orders two hits by their sort values, missing values last
+func compareSortValues(a, b []interface{}, sorts []sortField) int {
+	for i, sf := range sorts {
+		var c int
+		switch {
+		case a[i] == nil && b[i] == nil:
+			continue
+		case a[i] == nil:
+			return 1
+		case b[i] == nil:
+			return -1
+		}
+		c = compare(sortable(a[i]), sortable(b[i]))
+		if sf.desc {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func sortable(v interface{}) interface{} {
+	if i, ok := v.(int64); ok {
+		return float64(i)
+	}
+	return v
+}
+
+type sourceFilter struct {
+	disabled bool
+	includes []string
+	excludes []string
+}
+
+func decodeSourceFilter(raw json.RawMessage) (sourceFilter, error) {
+	var f sourceFilter
+	if len(bytes.TrimSpace(raw)) == 0 {
+		return f, nil
+	}
+	var v interface{}
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return f, fmt.Errorf("malformed _source: %v", err)
+	}
+	switch v := v.(type) {
+	case bool:
+		f.disabled = !v
+	case string:
+		f.includes = []string{v}
+	case []interface{}:
+		f.includes = stringList(v)
+	case map[string]interface{}:
+		for k, p := range v {
+			var patterns []string
+			switch p := p.(type) {
+			case string:
+				patterns = []string{p}
+			case []interface{}:
+				patterns = stringList(p)
+			}
+			switch k {
+			case "includes", "include":
+				f.includes = patterns
+			case "excludes", "exclude":
+				f.excludes = patterns
+			}
+		}
+	}
+	return f, nil
+}
+
+func stringList(list []interface{}) []string {
+	s := make([]string, len(list))
+	for i, v := range list {
+		s[i] = canonical(v)
+	}
+	return s
+}
+
+// apply returns the filtered source, nil when the source is not returned
+func (f sourceFilter) apply(source map[string]interface{}) map[string]interface{} {
+	if f.disabled {
+		return nil
+	}
+	if len(f.includes) == 0 && len(f.excludes) == 0 {
+		return source
+	}
+	return f.filter(source, "", len(f.includes) == 0)
+}
+
+func (f sourceFilter) filter(m map[string]interface{}, prefix string, included bool) map[string]interface{} {
+	res := make(map[string]interface{})
+	for k, v := range m {
+		p := prefix + k
+		if matchAny(f.excludes, p) {
+			continue
+		}
+		in := included || matchAny(f.includes, p)
+		if child, ok := v.(map[string]interface{}); ok {
+			if sub := f.filter(child, p+".", in); len(sub) > 0 || in && len(child) == 0 {
+				res[k] = sub
+			}
+			continue
+		}
+		if in {
+			res[k] = v
+		}
+	}
+	return res
+}
+
+func matchAny(patterns []string, p string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, p); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeValue(raw []byte) (interface{}, error) {
+	var v interface{}
+	d := json.NewDecoder(bytes.NewReader(raw))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func decodeObject(raw []byte) (map[string]interface{}, error) {
+	v, err := decodeValue(raw)
+	if err != nil {
+		return nil, err
+	}
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected an object")
+	}
+	return m, nil
+}
+
+// merge copies src into dst, merging nested objects as a partial document update does
+func merge(dst, src map[string]interface{}) {
+	for k, v := range src {
+		if sm, ok := v.(map[string]interface{}); ok {
+			if dm, ok := dst[k].(map[string]interface{}); ok {
+				merge(dm, sm)
+				continue
+			}
+		}
+		dst[k] = deepCopy(v)
+	}
+}
+
+func deepCopy(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			m[k] = deepCopy(e)
+		}
+		return m
+	case []interface{}:
+		l := make([]interface{}, len(v))
+		for i, e := range v {
+			l[i] = deepCopy(e)
+		}
+		return l
+	default:
+		return v
+	}
+}
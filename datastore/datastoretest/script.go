@@ -0,0 +1,184 @@
+package datastoretest
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// script is an update script; the painless subset understood is a sequence of
+// assignments of literals or params to source fields, as used by oipd:
+//
+//	ctx._source.meta.deactivated=true;
+//	ctx._source.meta.publisher_name=params.pubName;
+type script struct {
+	statements []assignment
+	params     map[string]interface{}
+}
+
+type assignment struct {
+	path  []string
+	value func(params map[string]interface{}) (interface{}, error)
+}
+
+func decodeScript(raw json.RawMessage) (*script, error) {
+	v, err := decodeValue(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var source string
+	var params map[string]interface{}
+	switch v := v.(type) {
+	case string:
+		source = v
+	case map[string]interface{}:
+		if lang, ok := v["lang"].(string); ok && lang != "painless" {
+			return nil, fmt.Errorf("unsupported script lang %s", lang)
+		}
+		if _, ok := v["id"]; ok {
+			return nil, fmt.Errorf("stored scripts are not supported")
+		}
+		source, _ = v["source"].(string)
+		if source == "" {
+			source, _ = v["inline"].(string)
+		}
+		params, _ = v["params"].(map[string]interface{})
+	default:
+		return nil, fmt.Errorf("malformed script")
+	}
+
+	sc := &script{params: params}
+	for _, stmt := range splitStatements(source) {
+		a, err := parseAssignment(stmt)
+		if err != nil {
+			return nil, err
+		}
+		sc.statements = append(sc.statements, a)
+	}
+	return sc, nil
+}
+
+// splitStatements splits source on semicolons outside of string literals
+func splitStatements(source string) []string {
+	var statements []string
+	var quote byte
+	start := 0
+	for i := 0; i < len(source); i++ {
+		c := source[i]
+		switch {
+		case quote != 0 && c == '\\':
+			i++
+		case quote != 0 && c == quote:
+			quote = 0
+		case quote == 0 && (c == '"' || c == '\''):
+			quote = c
+		case quote == 0 && c == ';':
+			statements = append(statements, source[start:i])
+			start = i + 1
+		}
+	}
+	statements = append(statements, source[start:])
+
+	var res []string
+	for _, s := range statements {
+		if s = strings.TrimSpace(s); s != "" {
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+func parseAssignment(stmt string) (assignment, error) {
+	i := strings.Index(stmt, "=")
+	if i < 0 || strings.HasPrefix(stmt[i:], "==") {
+		return assignment{}, fmt.Errorf("unsupported statement %q", stmt)
+	}
+	lhs := strings.TrimSpace(stmt[:i])
+	rhs := strings.TrimSpace(stmt[i+1:])
+
+	if !strings.HasPrefix(lhs, "ctx._source.") {
+		return assignment{}, fmt.Errorf("unsupported assignment target %q", lhs)
+	}
+	a := assignment{path: strings.Split(strings.TrimPrefix(lhs, "ctx._source."), ".")}
+	for _, key := range a.path {
+		if !isIdentifier(key) {
+			return assignment{}, fmt.Errorf("unsupported assignment target %q", lhs)
+		}
+	}
+
+	switch {
+	case strings.HasPrefix(rhs, "params."):
+		name := strings.TrimPrefix(rhs, "params.")
+		if !isIdentifier(name) {
+			return assignment{}, fmt.Errorf("unsupported expression %q", rhs)
+		}
+		a.value = func(params map[string]interface{}) (interface{}, error) {
+			v, ok := params[name]
+			if !ok {
+				return nil, fmt.Errorf("missing param %s", name)
+			}
+			return deepCopy(v), nil
+		}
+	case len(rhs) >= 2 && (rhs[0] == '"' || rhs[0] == '\'') && rhs[len(rhs)-1] == rhs[0]:
+		s := rhs
+		if rhs[0] == '\'' {
+			s = `"` + strings.Replace(strings.Replace(rhs[1:len(rhs)-1], `"`, `\"`, -1), `\'`, `'`, -1) + `"`
+		}
+		str, err := strconv.Unquote(s)
+		if err != nil {
+			return assignment{}, fmt.Errorf("malformed string %s", rhs)
+		}
+		a.value = constant(str)
+	case rhs == "true" || rhs == "false":
+		a.value = constant(rhs == "true")
+	case rhs == "null":
+		a.value = constant(nil)
+	default:
+		if _, err := strconv.ParseFloat(rhs, 64); err != nil {
+			return assignment{}, fmt.Errorf("unsupported expression %q", rhs)
+		}
+		a.value = constant(json.Number(rhs))
+	}
+	return a, nil
+}
+
+func constant(v interface{}) func(map[string]interface{}) (interface{}, error) {
+	return func(map[string]interface{}) (interface{}, error) {
+		return v, nil
+	}
+}
+
+func isIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, c := range s {
+		if c != '_' && (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (i == 0 || c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// run executes the script against source; as in painless, assigning below a
+// missing object fails rather than creating it
+func (sc *script) run(source map[string]interface{}) error {
+	for _, a := range sc.statements {
+		v, err := a.value(sc.params)
+		if err != nil {
+			return err
+		}
+		m := source
+		for i, key := range a.path[:len(a.path)-1] {
+			next, ok := m[key].(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("null pointer exception at ctx._source.%s", strings.Join(a.path[:i+1], "."))
+			}
+			m = next
+		}
+		m[a.path[len(a.path)-1]] = v
+	}
+	return nil
+}
@@ -0,0 +1,738 @@
+package datastoretest
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Server is an in-memory stand in for the subset of the Elasticsearch 6 REST api
+// used by oipd: indices, bulk requests, get, index, update, search, count and
+// update by query. Writes are visible immediately, as if every request asked
+// for a refresh. Every write is recorded and can be inspected with Actions.
+type Server struct {
+	srv *httptest.Server
+
+	mu      sync.Mutex
+	indices map[string]*index
+	actions []Action
+	seq     int64
+}
+
+// Action is a write received by the server, from a bulk request or otherwise
+type Action struct {
+	// index, create, update or delete
+	Action string
+	Index  string
+	Id     string
+	// document for index and create, update body for update
+	Body json.RawMessage
+	// http status the action completed with
+	Status int
+}
+
+type index struct {
+	docs map[string]*document
+}
+
+type document struct {
+	id      string
+	source  map[string]interface{}
+	version int64
+	// insertion order, results are returned in it when unsorted
+	seq int64
+}
+
+// NewServer starts a server holding no indices
+func NewServer() *Server {
+	s := &Server{indices: make(map[string]*index)}
+	s.srv = httptest.NewServer(s)
+	return s
+}
+
+// URL returns the url the server is listening on
+func (s *Server) URL() string {
+	return s.srv.URL
+}
+
+// Close stops the server
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Put stores doc under id in index as an index request would, without
+// recording an Action
+func (s *Server) Put(indexName, id string, doc interface{}) {
+	b, err := json.Marshal(doc)
+	if err != nil {
+		panic(fmt.Sprintf("datastoretest: unable to marshal document %s/%s: %v", indexName, id, err))
+	}
+	source, err := decodeObject(b)
+	if err != nil {
+		panic(fmt.Sprintf("datastoretest: document %s/%s is not an object: %v", indexName, id, err))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.put(indexName, id, source)
+}
+
+// Get returns the source of a stored document
+func (s *Server) Get(indexName, id string) (json.RawMessage, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d := s.doc(indexName, id)
+	if d == nil {
+		return nil, false
+	}
+	b, _ := json.Marshal(d.source)
+	return b, true
+}
+
+// IDs returns the sorted ids of the documents stored in index
+func (s *Server) IDs(indexName string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var ids []string
+	if idx, ok := s.indices[indexName]; ok {
+		for id := range idx.docs {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Actions returns the writes received so far
+func (s *Server) Actions() []Action {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Action(nil), s.actions...)
+}
+
+// ResetActions forgets the writes received so far
+func (s *Server) ResetActions() {
+	s.mu.Lock()
+	s.actions = nil
+	s.mu.Unlock()
+}
+
+// Reset removes all indices and recorded writes
+func (s *Server) Reset() {
+	s.mu.Lock()
+	s.indices = make(map[string]*index)
+	s.actions = nil
+	s.mu.Unlock()
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "parse_exception", err.Error())
+		return
+	}
+
+	var segs []string
+	if p := strings.Trim(r.URL.Path, "/"); p != "" {
+		segs = strings.Split(p, "/")
+	}
+	var indices []string
+	if len(segs) > 0 && !strings.HasPrefix(segs[0], "_") {
+		indices = strings.Split(segs[0], ",")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	last := ""
+	if len(segs) > 0 {
+		last = segs[len(segs)-1]
+	}
+	switch {
+	case last == "_bulk":
+		def := ""
+		if len(indices) == 1 {
+			def = indices[0]
+		}
+		s.bulk(w, def, body)
+	case last == "_search":
+		s.search(w, indices, body)
+	case last == "_count":
+		s.count(w, indices, body)
+	case last == "_update_by_query":
+		s.updateByQuery(w, indices, body)
+	case last == "_delete_by_query":
+		s.deleteByQuery(w, indices, body)
+	case last == "_refresh":
+		respond(w, http.StatusOK, map[string]interface{}{"_shards": shards()})
+	case len(segs) == 2 && segs[0] == "_cluster" && segs[1] == "health":
+		respond(w, http.StatusOK, map[string]interface{}{"cluster_name": "datastoretest", "status": "green"})
+	case len(segs) == 1 && indices != nil:
+		s.serveIndex(w, r, segs[0])
+	case len(segs) == 2 && indices != nil && r.Method == http.MethodPost:
+		s.seq++
+		s.serveIndexDoc(w, segs[0], "auto-"+strconv.FormatInt(s.seq, 10), body)
+	case len(segs) == 3 && indices != nil:
+		s.serveDoc(w, r, segs[0], segs[2], body)
+	case len(segs) == 4 && indices != nil && last == "_update":
+		status, res := s.update(segs[0], segs[2], body)
+		respond(w, status, res)
+	default:
+		respondError(w, http.StatusBadRequest, "illegal_argument_exception",
+			fmt.Sprintf("datastoretest does not support %s %s", r.Method, r.URL.Path))
+	}
+}
+
+func (s *Server) serveIndex(w http.ResponseWriter, r *http.Request, name string) {
+	switch r.Method {
+	case http.MethodHead:
+		if _, ok := s.indices[name]; ok {
+			w.WriteHeader(http.StatusOK)
+		} else {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	case http.MethodPut:
+		if _, ok := s.indices[name]; ok {
+			respondError(w, http.StatusBadRequest, "resource_already_exists_exception", "index ["+name+"] already exists")
+			return
+		}
+		s.indices[name] = &index{docs: make(map[string]*document)}
+		respond(w, http.StatusOK, map[string]interface{}{"acknowledged": true, "shards_acknowledged": true, "index": name})
+	case http.MethodDelete:
+		if _, ok := s.indices[name]; !ok {
+			respondError(w, http.StatusNotFound, "index_not_found_exception", "no such index")
+			return
+		}
+		delete(s.indices, name)
+		respond(w, http.StatusOK, map[string]interface{}{"acknowledged": true})
+	default:
+		respondError(w, http.StatusMethodNotAllowed, "illegal_argument_exception", "method not allowed")
+	}
+}
+
+func (s *Server) serveDoc(w http.ResponseWriter, r *http.Request, indexName, id string, body []byte) {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		res := map[string]interface{}{"_index": indexName, "_type": "_doc", "_id": id, "found": false}
+		d := s.doc(indexName, id)
+		if d == nil {
+			respond(w, http.StatusNotFound, res)
+			return
+		}
+		res["found"] = true
+		res["_version"] = d.version
+		res["_source"] = d.source
+		respond(w, http.StatusOK, res)
+	case http.MethodPut, http.MethodPost:
+		s.serveIndexDoc(w, indexName, id, body)
+	case http.MethodDelete:
+		status, res := s.delete(indexName, id)
+		respond(w, status, res)
+	default:
+		respondError(w, http.StatusMethodNotAllowed, "illegal_argument_exception", "method not allowed")
+	}
+}
+
+func (s *Server) serveIndexDoc(w http.ResponseWriter, indexName, id string, body []byte) {
+	status, res := s.index("index", indexName, id, body)
+	respond(w, status, res)
+}
+
+// index stores body as the document id, recording the action; requires lock
+func (s *Server) index(action, indexName, id string, body []byte) (int, map[string]interface{}) {
+	source, err := decodeObject(body)
+	if err != nil {
+		return s.record(Action{Action: action, Index: indexName, Id: id, Body: body},
+			http.StatusBadRequest, errorBody("mapper_parsing_exception", "failed to parse: "+err.Error()))
+	}
+	if action == "create" && s.doc(indexName, id) != nil {
+		return s.record(Action{Action: action, Index: indexName, Id: id, Body: body},
+			http.StatusConflict, errorBody("version_conflict_engine_exception", "["+id+"]: document already exists"))
+	}
+
+	result, status := "created", http.StatusCreated
+	if s.doc(indexName, id) != nil {
+		result, status = "updated", http.StatusOK
+	}
+	d := s.put(indexName, id, source)
+	return s.record(Action{Action: action, Index: indexName, Id: id, Body: body}, status, writeResult(indexName, d, result))
+}
+
+// update applies a partial document or script to the document id, recording the
+// action; requires lock
+func (s *Server) update(indexName, id string, body []byte) (int, map[string]interface{}) {
+	a := Action{Action: "update", Index: indexName, Id: id, Body: body}
+
+	var req struct {
+		Doc         json.RawMessage `json:"doc"`
+		DocAsUpsert bool            `json:"doc_as_upsert"`
+		Upsert      json.RawMessage `json:"upsert"`
+		Script      json.RawMessage `json:"script"`
+	}
+	if err := json.Unmarshal(body, &req); err != nil {
+		return s.record(a, http.StatusBadRequest, errorBody("parse_exception", err.Error()))
+	}
+
+	d := s.doc(indexName, id)
+	if d == nil {
+		var upsert []byte
+		switch {
+		case req.Upsert != nil:
+			upsert = req.Upsert
+		case req.DocAsUpsert && req.Doc != nil:
+			upsert = req.Doc
+		default:
+			return s.record(a, http.StatusNotFound,
+				errorBody("document_missing_exception", "[_doc]["+id+"]: document missing"))
+		}
+		source, err := decodeObject(upsert)
+		if err != nil {
+			return s.record(a, http.StatusBadRequest, errorBody("mapper_parsing_exception", err.Error()))
+		}
+		d = s.put(indexName, id, source)
+		return s.record(a, http.StatusCreated, writeResult(indexName, d, "created"))
+	}
+
+	source := deepCopy(d.source).(map[string]interface{})
+	switch {
+	case req.Script != nil:
+		sc, err := decodeScript(req.Script)
+		if err == nil {
+			err = sc.run(source)
+		}
+		if err != nil {
+			return s.record(a, http.StatusBadRequest, errorBody("illegal_argument_exception", "failed to execute script: "+err.Error()))
+		}
+	case req.Doc != nil:
+		doc, err := decodeObject(req.Doc)
+		if err != nil {
+			return s.record(a, http.StatusBadRequest, errorBody("mapper_parsing_exception", err.Error()))
+		}
+		merge(source, doc)
+	default:
+		return s.record(a, http.StatusBadRequest, errorBody("action_request_validation_exception", "script or doc is missing"))
+	}
+
+	d.source = source
+	d.version++
+	return s.record(a, http.StatusOK, writeResult(indexName, d, "updated"))
+}
+
+// delete removes the document id, recording the action; requires lock
+func (s *Server) delete(indexName, id string) (int, map[string]interface{}) {
+	a := Action{Action: "delete", Index: indexName, Id: id}
+	d := s.doc(indexName, id)
+	if d == nil {
+		return s.record(a, http.StatusNotFound, map[string]interface{}{
+			"_index": indexName, "_type": "_doc", "_id": id, "result": "not_found", "_shards": shards(),
+		})
+	}
+	delete(s.indices[indexName].docs, id)
+	d.version++
+	return s.record(a, http.StatusOK, writeResult(indexName, d, "deleted"))
+}
+
+func (s *Server) bulk(w http.ResponseWriter, defIndex string, body []byte) {
+	var items []map[string]interface{}
+	hasErrors := false
+
+	sc := bufio.NewScanner(bytes.NewReader(body))
+	sc.Buffer(make([]byte, 64*1024), len(body)+1)
+	for sc.Scan() {
+		line := bytes.TrimSpace(sc.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var meta map[string]struct {
+			Index string `json:"_index"`
+			Id    string `json:"_id"`
+		}
+		if err := json.Unmarshal(line, &meta); err != nil || len(meta) != 1 {
+			respondError(w, http.StatusBadRequest, "illegal_argument_exception", "malformed action/metadata line")
+			return
+		}
+		for action, m := range meta {
+			indexName := m.Index
+			if indexName == "" {
+				indexName = defIndex
+			}
+
+			var source []byte
+			if action != "delete" {
+				if !sc.Scan() {
+					respondError(w, http.StatusBadRequest, "illegal_argument_exception", "missing source for "+action)
+					return
+				}
+				source = append([]byte(nil), sc.Bytes()...)
+			}
+
+			var status int
+			var res map[string]interface{}
+			switch action {
+			case "index", "create":
+				id := m.Id
+				if id == "" {
+					s.seq++
+					id = "auto-" + strconv.FormatInt(s.seq, 10)
+				}
+				status, res = s.index(action, indexName, id, source)
+			case "update":
+				status, res = s.update(indexName, m.Id, source)
+			case "delete":
+				status, res = s.delete(indexName, m.Id)
+			default:
+				respondError(w, http.StatusBadRequest, "illegal_argument_exception", "unknown bulk action "+action)
+				return
+			}
+
+			res["status"] = status
+			if e, ok := res["error"]; ok {
+				hasErrors = true
+				// bulk items carry only the cause rather than a full error response
+				res["error"] = e.(map[string]interface{})["root_cause"].([]interface{})[0]
+			}
+			items = append(items, map[string]interface{}{action: res})
+		}
+	}
+	if err := sc.Err(); err != nil {
+		respondError(w, http.StatusBadRequest, "illegal_argument_exception", err.Error())
+		return
+	}
+
+	respond(w, http.StatusOK, map[string]interface{}{"took": 1, "errors": hasErrors, "items": items})
+}
+
+func (s *Server) search(w http.ResponseWriter, indices []string, body []byte) {
+	var req struct {
+		Query       json.RawMessage   `json:"query"`
+		From        int               `json:"from"`
+		Size        *int              `json:"size"`
+		Sort        json.RawMessage   `json:"sort"`
+		SearchAfter []json.RawMessage `json:"search_after"`
+		Source      json.RawMessage   `json:"_source"`
+		Aggs        json.RawMessage   `json:"aggs"`
+		Aggregation json.RawMessage   `json:"aggregations"`
+	}
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &req); err != nil {
+			respondError(w, http.StatusBadRequest, "parsing_exception", err.Error())
+			return
+		}
+	}
+	if req.Aggs != nil || req.Aggregation != nil {
+		respondError(w, http.StatusBadRequest, "parsing_exception", "datastoretest does not support aggregations")
+		return
+	}
+
+	matches, err := s.match(indices, req.Query)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "parsing_exception", err.Error())
+		return
+	}
+	sorts, err := decodeSort(req.Sort)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "parsing_exception", err.Error())
+		return
+	}
+	sortHits(matches, sorts)
+
+	total := len(matches)
+	if req.SearchAfter != nil {
+		if len(req.SearchAfter) != len(sorts) {
+			respondError(w, http.StatusBadRequest, "illegal_argument_exception", "search_after has a different number of values than sort")
+			return
+		}
+		after := make([]interface{}, len(req.SearchAfter))
+		for i, raw := range req.SearchAfter {
+			after[i], _ = decodeValue(raw)
+		}
+		i := 0
+		for i < len(matches) && compareSortValues(matches[i].sortValues(sorts), after, sorts) <= 0 {
+			i++
+		}
+		matches = matches[i:]
+	}
+
+	if req.From > len(matches) {
+		req.From = len(matches)
+	}
+	matches = matches[req.From:]
+	size := 10
+	if req.Size != nil {
+		size = *req.Size
+	}
+	if size < len(matches) {
+		matches = matches[:size]
+	}
+
+	filter, err := decodeSourceFilter(req.Source)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "parsing_exception", err.Error())
+		return
+	}
+
+	hits := make([]map[string]interface{}, 0, len(matches))
+	for _, h := range matches {
+		hit := map[string]interface{}{
+			"_index": h.index,
+			"_type":  "_doc",
+			"_id":    h.doc.id,
+			"_score": 1.0,
+		}
+		if src := filter.apply(h.doc.source); src != nil {
+			hit["_source"] = src
+		}
+		if len(sorts) > 0 {
+			hit["_score"] = nil
+			hit["sort"] = h.sortValues(sorts)
+		}
+		hits = append(hits, hit)
+	}
+
+	respond(w, http.StatusOK, map[string]interface{}{
+		"took":      1,
+		"timed_out": false,
+		"_shards":   shards(),
+		"hits": map[string]interface{}{
+			"total":     total,
+			"max_score": 1.0,
+			"hits":      hits,
+		},
+	})
+}
+
+func (s *Server) count(w http.ResponseWriter, indices []string, body []byte) {
+	var req struct {
+		Query json.RawMessage `json:"query"`
+	}
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &req); err != nil {
+			respondError(w, http.StatusBadRequest, "parsing_exception", err.Error())
+			return
+		}
+	}
+	matches, err := s.match(indices, req.Query)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "parsing_exception", err.Error())
+		return
+	}
+	respond(w, http.StatusOK, map[string]interface{}{"count": len(matches), "_shards": shards()})
+}
+
+func (s *Server) updateByQuery(w http.ResponseWriter, indices []string, body []byte) {
+	var req struct {
+		Query  json.RawMessage `json:"query"`
+		Script json.RawMessage `json:"script"`
+	}
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &req); err != nil {
+			respondError(w, http.StatusBadRequest, "parsing_exception", err.Error())
+			return
+		}
+	}
+	matches, err := s.match(indices, req.Query)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "parsing_exception", err.Error())
+		return
+	}
+
+	var failures []interface{}
+	updated, noops := 0, 0
+	for _, h := range matches {
+		if req.Script == nil {
+			noops++
+			continue
+		}
+		status, res := s.update(h.index, h.doc.id, []byte(`{"script":`+string(req.Script)+`}`))
+		if status != http.StatusOK {
+			failures = append(failures, map[string]interface{}{
+				"index": h.index, "type": "_doc", "id": h.doc.id, "status": status, "cause": res["error"],
+			})
+			continue
+		}
+		updated++
+	}
+	if failures == nil {
+		failures = []interface{}{}
+	}
+
+	respond(w, http.StatusOK, map[string]interface{}{
+		"took":              1,
+		"timed_out":         false,
+		"total":             len(matches),
+		"updated":           updated,
+		"deleted":           0,
+		"batches":           1,
+		"version_conflicts": 0,
+		"noops":             noops,
+		"failures":          failures,
+	})
+}
+
+func (s *Server) deleteByQuery(w http.ResponseWriter, indices []string, body []byte) {
+	var req struct {
+		Query json.RawMessage `json:"query"`
+	}
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &req); err != nil {
+			respondError(w, http.StatusBadRequest, "parsing_exception", err.Error())
+			return
+		}
+	}
+	matches, err := s.match(indices, req.Query)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "parsing_exception", err.Error())
+		return
+	}
+
+	for _, h := range matches {
+		s.delete(h.index, h.doc.id)
+	}
+
+	respond(w, http.StatusOK, map[string]interface{}{
+		"took":              1,
+		"timed_out":         false,
+		"total":             len(matches),
+		"updated":           0,
+		"deleted":           len(matches),
+		"batches":           1,
+		"version_conflicts": 0,
+		"noops":             0,
+		"failures":          []interface{}{},
+	})
+}
+
+type hit struct {
+	index string
+	doc   *document
+}
+
+func (h hit) sortValues(sorts []sortField) []interface{} {
+	vals := make([]interface{}, len(sorts))
+	for i, sf := range sorts {
+		vals[i] = sf.value(h)
+	}
+	return vals
+}
+
+// match returns the documents of the indices matching query in insertion
+// order; requires lock
+func (s *Server) match(patterns []string, query json.RawMessage) ([]hit, error) {
+	q, err := decodeQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for name := range s.indices {
+		if len(patterns) == 0 {
+			names = append(names, name)
+			continue
+		}
+		for _, p := range patterns {
+			if p == "_all" || p == name {
+				names = append(names, name)
+				break
+			}
+			if ok, _ := path.Match(p, name); ok {
+				names = append(names, name)
+				break
+			}
+		}
+	}
+
+	var hits []hit
+	for _, name := range names {
+		for _, d := range s.indices[name].docs {
+			if q.matches(name, d) {
+				hits = append(hits, hit{index: name, doc: d})
+			}
+		}
+	}
+	sort.SliceStable(hits, func(i, j int) bool {
+		return hits[i].doc.seq < hits[j].doc.seq
+	})
+	return hits, nil
+}
+
+// requires lock
+func (s *Server) doc(indexName, id string) *document {
+	idx, ok := s.indices[indexName]
+	if !ok {
+		return nil
+	}
+	return idx.docs[id]
+}
+
+// put replaces the document id, creating the index as needed; requires lock
+func (s *Server) put(indexName, id string, source map[string]interface{}) *document {
+	idx, ok := s.indices[indexName]
+	if !ok {
+		idx = &index{docs: make(map[string]*document)}
+		s.indices[indexName] = idx
+	}
+	s.seq++
+	d := &document{id: id, source: source, version: 1, seq: s.seq}
+	if prev, ok := idx.docs[id]; ok {
+		d.version = prev.version + 1
+		d.seq = prev.seq
+	}
+	idx.docs[id] = d
+	return d
+}
+
+// requires lock
+func (s *Server) record(a Action, status int, res map[string]interface{}) (int, map[string]interface{}) {
+	a.Status = status
+	s.actions = append(s.actions, a)
+	return status, res
+}
+
+func writeResult(indexName string, d *document, result string) map[string]interface{} {
+	return map[string]interface{}{
+		"_index":        indexName,
+		"_type":         "_doc",
+		"_id":           d.id,
+		"_version":      d.version,
+		"result":        result,
+		"_shards":       shards(),
+		"_seq_no":       d.seq,
+		"_primary_term": 1,
+	}
+}
+
+func shards() map[string]interface{} {
+	return map[string]interface{}{"total": 1, "successful": 1, "failed": 0}
+}
+
+func errorBody(typ, reason string) map[string]interface{} {
+	cause := map[string]interface{}{"type": typ, "reason": reason}
+	return map[string]interface{}{
+		"error": map[string]interface{}{
+			"root_cause": []interface{}{cause},
+			"type":       typ,
+			"reason":     reason,
+		},
+	}
+}
+
+func respondError(w http.ResponseWriter, status int, typ, reason string) {
+	res := errorBody(typ, reason)
+	res["status"] = status
+	respond(w, status, res)
+}
+
+func respond(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
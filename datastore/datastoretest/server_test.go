@@ -0,0 +1,176 @@
+package datastoretest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func do(t *testing.T, s *Server, method, path, body string) (int, map[string]interface{}) {
+	t.Helper()
+	req, err := http.NewRequest(method, s.URL()+path, bytes.NewBufferString(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var v map[string]interface{}
+	if len(b) > 0 {
+		if err := json.Unmarshal(b, &v); err != nil {
+			t.Fatalf("%s %s: %v %s", method, path, err, b)
+		}
+	}
+	return res.StatusCode, v
+}
+
+func hitIds(t *testing.T, res map[string]interface{}) []string {
+	t.Helper()
+	ids := []string{}
+	for _, h := range res["hits"].(map[string]interface{})["hits"].([]interface{}) {
+		ids = append(ids, h.(map[string]interface{})["_id"].(string))
+	}
+	return ids
+}
+
+func TestBulk(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	status, res := do(t, s, "POST", "/_bulk?refresh=true", `{"index":{"_index":"edits","_type":"_doc","_id":"a"}}
+{"meta":{"completed":false,"time":10}}
+{"update":{"_index":"edits","_type":"_doc","_id":"a"}}
+{"script":{"source":"ctx._source.meta.completed=true;","lang":"painless"}}
+{"update":{"_index":"edits","_type":"_doc","_id":"missing"}}
+{"doc":{"meta":{"completed":true}}}
+{"update":{"_index":"edits","_type":"_doc","_id":"b"}}
+{"doc":{"meta":{"time":20}},"doc_as_upsert":true}
+{"delete":{"_index":"edits","_type":"_doc","_id":"b"}}
+`)
+	if status != http.StatusOK || res["errors"] != true {
+		t.Fatalf("unexpected bulk response %d %v", status, res)
+	}
+	var statuses []int
+	for _, item := range res["items"].([]interface{}) {
+		for _, r := range item.(map[string]interface{}) {
+			statuses = append(statuses, int(r.(map[string]interface{})["status"].(float64)))
+		}
+	}
+	if want := []int{201, 200, 404, 201, 200}; !reflect.DeepEqual(statuses, want) {
+		t.Errorf("item statuses %v, want %v", statuses, want)
+	}
+
+	doc, ok := s.Get("edits", "a")
+	if !ok || string(doc) != `{"meta":{"completed":true,"time":10}}` {
+		t.Errorf("unexpected document %s", doc)
+	}
+	if ids := s.IDs("edits"); !reflect.DeepEqual(ids, []string{"a"}) {
+		t.Errorf("ids %v", ids)
+	}
+	actions := s.Actions()
+	if len(actions) != 5 || actions[2].Action != "update" || actions[2].Id != "missing" || actions[2].Status != 404 {
+		t.Errorf("unexpected actions %+v", actions)
+	}
+	s.ResetActions()
+	if len(s.Actions()) != 0 {
+		t.Error("actions not reset")
+	}
+
+	status, _ = do(t, s, "POST", "/edits/_doc/a/_update", `{"script":"ctx._source.missing.field=1;"}`)
+	if status != http.StatusBadRequest {
+		t.Errorf("script assigning below a missing object returned %d", status)
+	}
+}
+
+func TestSearch(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	now := time.Now().Unix()
+	s.Put("records", "r1", map[string]interface{}{"meta": map[string]interface{}{"txid": "aa01", "time": now - 10, "invalid": false}, "tags": []string{"x", "y"}})
+	s.Put("records", "r2", map[string]interface{}{"meta": map[string]interface{}{"txid": "aa02", "time": now - 20, "invalid": true}})
+	s.Put("records", "r3", map[string]interface{}{"meta": map[string]interface{}{"txid": "bb03", "time": now - 30*24*3600, "invalid": false}})
+	s.Put("other", "o1", map[string]interface{}{"meta": map[string]interface{}{"txid": "aa04"}})
+
+	cases := []struct {
+		name string
+		path string
+		body string
+		want []string
+	}{
+		{"match all", "/records/_search", `{}`, []string{"r1", "r2", "r3"}},
+		{"term", "/records/_search", `{"query":{"term":{"meta.invalid":false}}}`, []string{"r1", "r3"}},
+		{"term array", "/records/_search", `{"query":{"term":{"tags":{"value":"y"}}}}`, []string{"r1"}},
+		{"prefix across indices", "/records,other/_search", `{"query":{"prefix":{"meta.txid":"aa"}}}`, []string{"r1", "r2", "o1"}},
+		{"range date math", "/records/_search", `{"query":{"range":{"meta.time":{"from":null,"to":"now-1w","include_lower":true,"include_upper":true}}}}`, []string{"r3"}},
+		{"range numbers", "/records/_search", `{"query":{"range":{"meta.time":{"gt":` + strconv.FormatInt(now-15, 10) + `}}}}`, []string{"r1"}},
+		{"bool", "/records/_search", `{"query":{"bool":{"must":[{"prefix":{"meta.txid":"aa"}}],"must_not":{"term":{"meta.invalid":true}}}}}`, []string{"r1"}},
+		{"should", "/records/_search", `{"query":{"bool":{"should":[{"term":{"meta.txid":"aa02"}},{"term":{"meta.txid":"bb03"}}]}}}`, []string{"r2", "r3"}},
+		{"exists", "/records/_search", `{"query":{"exists":{"field":"tags"}}}`, []string{"r1"}},
+		{"sort desc", "/records/_search", `{"sort":[{"meta.time":{"order":"desc"}}]}`, []string{"r1", "r2", "r3"}},
+		{"sort asc size", "/records/_search", `{"size":2,"sort":[{"meta.time":{"order":"asc"}}]}`, []string{"r3", "r2"}},
+		{"search after", "/records/_search", `{"sort":[{"meta.time":{"order":"desc"}},{"meta.txid":{"order":"asc"}}],"search_after":[` + strconv.FormatInt(now-10, 10) + `,"aa01"]}`, []string{"r2", "r3"}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			status, res := do(t, s, "POST", c.path, c.body)
+			if status != http.StatusOK {
+				t.Fatalf("status %d %v", status, res)
+			}
+			if ids := hitIds(t, res); !reflect.DeepEqual(ids, c.want) {
+				t.Errorf("hits %v, want %v", ids, c.want)
+			}
+		})
+	}
+
+	_, res := do(t, s, "POST", "/records/_search", `{"query":{"ids":{"values":["r1"]}},"_source":{"includes":["meta.*"],"excludes":["meta.time"]}}`)
+	hit := res["hits"].(map[string]interface{})["hits"].([]interface{})[0].(map[string]interface{})
+	if want := map[string]interface{}{"meta": map[string]interface{}{"txid": "aa01", "invalid": false}}; !reflect.DeepEqual(hit["_source"], want) {
+		t.Errorf("filtered source %v, want %v", hit["_source"], want)
+	}
+
+	if status, _ := do(t, s, "POST", "/records/_search", `{"query":{"fuzzy":{"meta.txid":"aa"}}}`); status != http.StatusBadRequest {
+		t.Errorf("unsupported query returned %d", status)
+	}
+}
+
+func TestUpdateByQuery(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	s.Put("records", "r1", map[string]interface{}{"meta": map[string]interface{}{"signed_by": "F1"}})
+	s.Put("records", "r2", map[string]interface{}{"meta": map[string]interface{}{"signed_by": "F2"}})
+
+	status, res := do(t, s, "POST", "/records/_update_by_query?refresh=true", `{"query":{"term":{"meta.signed_by":"F1"}},
+		"script":{"source":"ctx._source.meta.publisher_name=params.pubName;","lang":"painless","params":{"pubName":"Ryan"}}}`)
+	if status != http.StatusOK || res["updated"] != 1.0 {
+		t.Fatalf("unexpected response %d %v", status, res)
+	}
+	doc, _ := s.Get("records", "r1")
+	if string(doc) != `{"meta":{"publisher_name":"Ryan","signed_by":"F1"}}` {
+		t.Errorf("unexpected document %s", doc)
+	}
+	_, res = do(t, s, "POST", "/records/_count", `{"query":{"exists":{"field":"meta.publisher_name"}}}`)
+	if res["count"] != 1.0 {
+		t.Errorf("count %v, want 1", res["count"])
+	}
+
+	status, res = do(t, s, "POST", "/records/_delete_by_query?refresh=true", `{"query":{"term":{"meta.signed_by":"F2"}}}`)
+	if status != http.StatusOK || res["deleted"] != 1.0 {
+		t.Fatalf("unexpected response %d %v", status, res)
+	}
+	if ids := s.IDs("records"); !reflect.DeepEqual(ids, []string{"r1"}) {
+		t.Errorf("ids after delete by query %v", ids)
+	}
+}
@@ -0,0 +1,45 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/oipwg/oip/health"
+)
+
+func init() {
+	health.Register("elasticsearch", checkElastic)
+	health.Register("timedCommit", checkTimedCommit)
+}
+
+// checkElastic verifies the cluster is reachable and able to serve requests
+func checkElastic(ctx context.Context) (string, error) {
+	if client == nil {
+		return "", errors.New("datastore not setup")
+	}
+	res, err := client.ClusterHealth().Do(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "cluster health failed")
+	}
+	if res.Status == "red" {
+		return "", errors.New("cluster status red")
+	}
+	return "cluster status " + res.Status, nil
+}
+
+// checkTimedCommit verifies the timed commit loop of AutoBulk is still iterating
+func checkTimedCommit(ctx context.Context) (string, error) {
+	last := AutoBulk.LastTimedCommit()
+	if last.IsZero() {
+		return "", errors.New("timed commits not running")
+	}
+	// a commit may legitimately take a while, allow a few missed ticks
+	since := time.Since(last)
+	if limit := 3*AutoBulk.TimedCommitRate() + time.Minute; since > limit {
+		return "", fmt.Errorf("no timed commit for %v", since.Round(time.Second))
+	}
+	return fmt.Sprintf("last commit %v ago", since.Round(time.Millisecond)), nil
+}
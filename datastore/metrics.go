@@ -0,0 +1,38 @@
+package datastore
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/oipwg/oip/metrics"
+)
+
+var (
+	bulkFlushActions = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "datastore",
+		Name:      "bulk_flush_actions",
+		Help:      "Number of actions per bulk flush",
+		Buckets:   prometheus.ExponentialBuckets(1, 4, 10),
+	})
+	bulkFlushBytes = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "datastore",
+		Name:      "bulk_flush_bytes",
+		Help:      "Estimated size of each bulk flush",
+		Buckets:   prometheus.ExponentialBuckets(1024, 4, 10),
+	})
+	bulkFlushDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "datastore",
+		Name:      "bulk_flush_duration_seconds",
+		Help:      "Time taken by each bulk flush",
+		Buckets:   prometheus.ExponentialBuckets(0.005, 3, 10),
+	})
+	bulkFlushFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "datastore",
+		Name:      "bulk_flush_failures_total",
+		Help:      "Failed bulk flushes (request) and failed actions within a flush (item)",
+	}, []string{"kind"})
+)
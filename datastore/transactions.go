@@ -7,6 +7,8 @@ import (
 	"github.com/bitspill/flod/flojson"
 	"github.com/pkg/errors"
 	"gopkg.in/olivere/elastic.v6"
+
+	"github.com/oipwg/oip/trace"
 )
 
 func init() {
@@ -27,8 +29,14 @@ func StoreTransaction(ctx context.Context, t *TransactionData) (*elastic.IndexRe
 	return put1, nil
 }
 
+// ErrNotFound is returned when a requested document does not exist
+var ErrNotFound = errors.New("ID not found")
+
 func GetTransactionFromID(ctx context.Context, id string) (TransactionData, error) {
 	get, err := client.Get().Index(Index("transactions")).Type("_doc").Id(id).Do(ctx)
+	if elastic.IsNotFound(err) {
+		return TransactionData{}, ErrNotFound
+	}
 	if err != nil {
 		return TransactionData{}, err
 	}
@@ -37,7 +45,7 @@ func GetTransactionFromID(ctx context.Context, id string) (TransactionData, erro
 		err := json.Unmarshal(*get.Source, &td)
 		return td, err
 	} else {
-		return TransactionData{}, errors.New("ID not found")
+		return TransactionData{}, ErrNotFound
 	}
 }
 
@@ -49,4 +57,14 @@ type TransactionData struct {
 	Transaction *flojson.TxRawResult `json:"tx"`
 	Fee         *float64             `json:"fee,omitempty"`
 	FeeSat      *int64               `json:"fee_sat,omitempty"`
+	// Set while the transaction is re-processed in dry-run mode, see trace
+	Trace *trace.Trace `json:"-"`
+}
+
+// GetTrace returns the trace of a transaction being re-processed in dry-run mode, nil otherwise
+func (td *TransactionData) GetTrace() *trace.Trace {
+	if td == nil {
+		return nil
+	}
+	return td.Trace
 }
@@ -0,0 +1,182 @@
+package dispatch
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/azer/logger"
+
+	"github.com/oipwg/oip/config"
+	"github.com/oipwg/oip/datastore"
+	"github.com/oipwg/oip/events"
+)
+
+// Window restricts a route to a range of block heights
+// Min is inclusive and Max exclusive, a zero bound is unbounded
+// Unconfirmed transactions have a height of -1 and only match windows without a Min
+type Window struct {
+	Min int64 `json:"min,omitempty"`
+	Max int64 `json:"max,omitempty"`
+}
+
+// Contains checks if height falls within the window
+func (w *Window) Contains(height int64) bool {
+	if w.Min != 0 && height < w.Min {
+		return false
+	}
+	if w.Max != 0 && height >= w.Max {
+		return false
+	}
+	return true
+}
+
+// Route publishes matching floData to a topic
+type Route struct {
+	// Unique name of the route, ex: oip:json
+	Name string
+	// Topic published with the matcher payload and the transaction
+	Topic string
+	Match Matcher
+	// Routes are tested from highest to lowest priority, equal priorities in registration order
+	Priority int
+	// Heights at which the route is active per network, nil disables the route on that network
+	Mainnet *Window
+	Testnet *Window
+	// Only match coinbase transactions
+	Coinbase bool
+	// Continue testing lower priority routes after a match
+	Fallthrough bool
+
+	matches int64
+}
+
+var (
+	routesMutex sync.RWMutex
+	routes      []*Route
+)
+
+func init() {
+	events.SubscribeAsync("flo:floData", onFloData)
+}
+
+// Register adds a route to the dispatcher
+// Registering a name a second time replaces the previous route
+func Register(r Route) {
+	routesMutex.Lock()
+	defer routesMutex.Unlock()
+
+	for i, existing := range routes {
+		if existing.Name == r.Name {
+			routes = append(routes[:i], routes[i+1:]...)
+			break
+		}
+	}
+	routes = append(routes, &r)
+	sort.SliceStable(routes, func(i, j int) bool {
+		return routes[i].Priority > routes[j].Priority
+	})
+}
+
+// Unregister removes the route registered with name, if any
+func Unregister(name string) {
+	routesMutex.Lock()
+	defer routesMutex.Unlock()
+
+	for i, existing := range routes {
+		if existing.Name == name {
+			routes = append(routes[:i], routes[i+1:]...)
+			return
+		}
+	}
+}
+
+func (r *Route) window(testnet bool) *Window {
+	if testnet {
+		return r.Testnet
+	}
+	return r.Mainnet
+}
+
+func (r *Route) applies(tx *datastore.TransactionData, testnet bool) bool {
+	w := r.window(testnet)
+	if w == nil || !w.Contains(tx.Block) {
+		return false
+	}
+	if r.Coinbase {
+		vin := tx.Transaction.Vin
+		if len(vin) == 0 || !vin[0].IsCoinBase() {
+			return false
+		}
+	}
+	return true
+}
+
+// onFloData tests floData against every route in a single pass
+func onFloData(floData string, tx *datastore.TransactionData) {
+	testnet := config.IsTestnet()
+
+	routesMutex.RLock()
+	rs := make([]*Route, len(routes))
+	copy(rs, routes)
+	routesMutex.RUnlock()
+
+	matched := false
+	for _, r := range rs {
+		if !r.applies(tx, testnet) {
+			continue
+		}
+		payload, ok := r.Match.Match(floData)
+		if !ok {
+			continue
+		}
+
+		matched = true
+		atomic.AddInt64(&r.matches, 1)
+		log.Info("route match", logger.Attrs{"txid": tx.Transaction.Txid, "route": r.Name, "topic": r.Topic})
+		tx.Trace.Note("route %s matched, %s", r.Name, r.Match)
+		events.Publish(r.Topic, payload, tx)
+		if !r.Fallthrough {
+			return
+		}
+	}
+	if !matched {
+		tx.Trace.Note("no route matched")
+	}
+}
+
+// RouteInfo describes a registered route
+type RouteInfo struct {
+	Name        string  `json:"name"`
+	Topic       string  `json:"topic"`
+	Match       string  `json:"match"`
+	Priority    int     `json:"priority"`
+	Mainnet     *Window `json:"mainnet"`
+	Testnet     *Window `json:"testnet"`
+	Coinbase    bool    `json:"coinbase"`
+	Fallthrough bool    `json:"fallthrough"`
+	// Number of transactions matched since startup
+	Matches int64 `json:"matches"`
+}
+
+// Routes lists the registered routes in dispatch order
+func Routes() []RouteInfo {
+	routesMutex.RLock()
+	defer routesMutex.RUnlock()
+
+	infos := make([]RouteInfo, 0, len(routes))
+	for _, r := range routes {
+		infos = append(infos, RouteInfo{
+			Name:        r.Name,
+			Topic:       r.Topic,
+			Match:       r.Match.String(),
+			Priority:    r.Priority,
+			Mainnet:     r.Mainnet,
+			Testnet:     r.Testnet,
+			Coinbase:    r.Coinbase,
+			Fallthrough: r.Fallthrough,
+			Matches:     atomic.LoadInt64(&r.matches),
+		})
+	}
+	return infos
+}
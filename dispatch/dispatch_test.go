@@ -0,0 +1,76 @@
+package dispatch
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/bitspill/flod/flojson"
+
+	"github.com/oipwg/oip/datastore"
+	"github.com/oipwg/oip/events"
+	"github.com/oipwg/oip/trace"
+)
+
+func TestMatchers(t *testing.T) {
+	cases := []struct {
+		m       Matcher
+		floData string
+		payload string
+		ok      bool
+	}{
+		{Prefix("Cancel: "), "Cancel: 123", "Cancel: 123", true},
+		{StripPrefix("json:"), `json:{"oip042":{}}`, `{"oip042":{}}`, true},
+		{StripPrefix("json:"), `p64:abc`, "", false},
+		{MinLength(8, StripPrefix("p64:")), "p64:abcd", "abcd", true},
+		{MinLength(8, StripPrefix("p64:")), "p64:abc", "", false},
+		{CompactPrefix(`{"oip-041":`), `{ "oip-041" : { "artifact": { "title": "x" } } }`, `{ "oip-041" : { "artifact": { "title": "x" } } }`, true},
+		{CompactPrefix(`{"oip-041":`), `{"oip-041":{}}`, "", false},
+		{Regex(regexp.MustCompile(`^https?://`)), "https://oip.io", "https://oip.io", true},
+		{JsonKey("alove"), ` {"alove": {"to": "you"}}`, `{"to": "you"}`, true},
+		{JsonKey("alove"), `{"other": 1}`, "", false},
+		{JsonKey("alove"), `alove`, "", false},
+	}
+	for _, c := range cases {
+		payload, ok := c.m.Match(c.floData)
+		if ok != c.ok || payload != c.payload {
+			t.Errorf("%s on %q: expected (%q, %v) got (%q, %v)", c.m, c.floData, c.payload, c.ok, payload, ok)
+		}
+	}
+}
+
+func TestWindow(t *testing.T) {
+	w := &Window{Min: 1000000, Max: 2400000}
+	if w.Contains(-1) || w.Contains(999999) || !w.Contains(1000000) || w.Contains(2400000) {
+		t.Error("unexpected window bounds")
+	}
+	if !(&Window{}).Contains(-1) {
+		t.Error("unbounded window should contain unconfirmed transactions")
+	}
+}
+
+func TestDispatchOrder(t *testing.T) {
+	var got []string
+	events.SubscribeAsync("test:dispatch:low", func(payload string, tx *datastore.TransactionData) {
+		got = append(got, "low:"+payload)
+	})
+	events.SubscribeAsync("test:dispatch:high", func(payload string, tx *datastore.TransactionData) {
+		got = append(got, "high:"+payload)
+	})
+	events.SubscribeAsync("test:dispatch:fallthrough", func(payload string, tx *datastore.TransactionData) {
+		got = append(got, "fallthrough:"+payload)
+	})
+
+	Register(Route{Name: "test:low", Topic: "test:dispatch:low", Match: StripPrefix("t:"), Priority: 1, Testnet: &Window{}})
+	Register(Route{Name: "test:high", Topic: "test:dispatch:high", Match: StripPrefix("t:"), Priority: 2, Testnet: &Window{}})
+	Register(Route{Name: "test:fallthrough", Topic: "test:dispatch:fallthrough", Match: Prefix("t:"), Priority: 3,
+		Testnet: &Window{}, Fallthrough: true})
+	Register(Route{Name: "test:mainnet", Topic: "test:dispatch:low", Match: Prefix("t:"), Priority: 4, Mainnet: &Window{}})
+
+	// traced transactions are dispatched synchronously
+	tx := &datastore.TransactionData{Transaction: &flojson.TxRawResult{Txid: "txid"}, Trace: trace.New("txid")}
+	onFloData("t:x", tx)
+
+	if len(got) != 2 || got[0] != "fallthrough:t:x" || got[1] != "high:x" {
+		t.Errorf("unexpected dispatch %v", got)
+	}
+}
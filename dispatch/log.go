@@ -0,0 +1,5 @@
+package dispatch
+
+import "github.com/azer/logger"
+
+var log = logger.New("dispatch")
@@ -0,0 +1,143 @@
+package dispatch
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/json-iterator/go"
+)
+
+// Matcher tests floData against a route
+// On a match the payload published to the route topic is returned
+type Matcher interface {
+	Match(floData string) (payload string, ok bool)
+	// String describes the matcher for reporting
+	String() string
+}
+
+type prefixMatcher struct {
+	prefix string
+	strip  bool
+}
+
+// Prefix matches floData starting with prefix, the full floData is published
+func Prefix(prefix string) Matcher {
+	return prefixMatcher{prefix: prefix}
+}
+
+// StripPrefix matches floData starting with prefix, the remainder is published
+func StripPrefix(prefix string) Matcher {
+	return prefixMatcher{prefix: prefix, strip: true}
+}
+
+func (m prefixMatcher) Match(floData string) (string, bool) {
+	if !strings.HasPrefix(floData, m.prefix) {
+		return "", false
+	}
+	if m.strip {
+		return strings.TrimPrefix(floData, m.prefix), true
+	}
+	return floData, true
+}
+
+func (m prefixMatcher) String() string {
+	if m.strip {
+		return "strip prefix " + strconv.Quote(m.prefix)
+	}
+	return "prefix " + strconv.Quote(m.prefix)
+}
+
+type minLengthMatcher struct {
+	min int
+	m   Matcher
+}
+
+// MinLength matches floData of at least min characters which m matches, the payload of m is published
+func MinLength(min int, m Matcher) Matcher {
+	return minLengthMatcher{min: min, m: m}
+}
+
+func (m minLengthMatcher) Match(floData string) (string, bool) {
+	if len(floData) < m.min {
+		return "", false
+	}
+	return m.m.Match(floData)
+}
+
+func (m minLengthMatcher) String() string {
+	return m.m.String() + " of at least " + strconv.Itoa(m.min) + " characters"
+}
+
+// length of the leading window compared by CompactPrefix
+const compactLen = 35
+
+type compactPrefixMatcher struct {
+	prefix string
+}
+
+// CompactPrefix matches floData whose first 35 characters, with all spaces removed, start with prefix
+// Tolerates the inconsistent whitespace of early json publishers, ex: `{ "alexandria-media" : {`
+// floData shorter than 35 characters never matches, the full floData is published
+func CompactPrefix(prefix string) Matcher {
+	return compactPrefixMatcher{prefix: prefix}
+}
+
+func (m compactPrefixMatcher) Match(floData string) (string, bool) {
+	if len(floData) < compactLen {
+		return "", false
+	}
+	simplified := strings.TrimSpace(floData[0:compactLen])
+	simplified = strings.Replace(simplified, " ", "", -1)
+	if strings.HasPrefix(simplified, m.prefix) {
+		return floData, true
+	}
+	return "", false
+}
+
+func (m compactPrefixMatcher) String() string {
+	return "compact prefix " + strconv.Quote(m.prefix)
+}
+
+type regexMatcher struct {
+	re *regexp.Regexp
+}
+
+// Regex matches floData matching the expression, the full floData is published
+func Regex(re *regexp.Regexp) Matcher {
+	return regexMatcher{re: re}
+}
+
+func (m regexMatcher) Match(floData string) (string, bool) {
+	return floData, m.re.MatchString(floData)
+}
+
+func (m regexMatcher) String() string {
+	return "regex " + strconv.Quote(m.re.String())
+}
+
+type jsonKeyMatcher struct {
+	key string
+}
+
+// JsonKey matches floData which is a json object containing the top level key
+// The value of the key is published, strings unquoted and other values as raw json
+func JsonKey(key string) Matcher {
+	return jsonKeyMatcher{key: key}
+}
+
+func (m jsonKeyMatcher) Match(floData string) (string, bool) {
+	trimmed := strings.TrimSpace(floData)
+	if !strings.HasPrefix(trimmed, "{") {
+		return "", false
+	}
+	v := jsoniter.Get([]byte(trimmed), m.key)
+	if v.LastError() != nil || v.ValueType() == jsoniter.InvalidValue {
+		return "", false
+	}
+	return v.ToString(), true
+}
+
+func (m jsonKeyMatcher) String() string {
+	return "json key " + strconv.Quote(m.key)
+}
@@ -1,31 +1,180 @@
 package events
 
 import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
 	"github.com/asaskevich/EventBus"
+
+	"github.com/oipwg/oip/trace"
 )
 
 var bus = EventBus.New()
 
+// wrapped handlers by topic, needed to unsubscribe
+var (
+	handlersMutex sync.Mutex
+	handlers      = make(map[string][]*wrappedHandler)
+)
+
+type wrappedHandler struct {
+	original reflect.Value
+	// the function subscribed to the bus in place of original
+	wrapper interface{}
+	removed int32
+	once    bool
+}
+
 // SubscribeAsync subscribes to a topic with an asynchronous callback
 // Subsequent callbacks for a topic are run concurrently
 // Does nothing if fn is not a function.
 func SubscribeAsync(topic string, fn interface{}) {
-	_ = bus.SubscribeAsync(topic, fn, false)
+	_ = bus.SubscribeAsync(topic, wrapHandler(topic, fn, false), false)
 }
 
 // SubscribeOnceAsync subscribes to a topic once with an asynchronous callback
 // Handler will be removed after executing.
 // Does nothing if fn is not a function.
 func SubscribeOnceAsync(topic string, fn interface{}) {
-	_ = bus.SubscribeOnceAsync(topic, fn)
+	_ = bus.SubscribeOnceAsync(topic, wrapHandler(topic, fn, true))
 }
 
 // Unsubscribe removes callback defined for a topic if it exists.
 func Unsubscribe(topic string, handler interface{}) {
-	_ = bus.Unsubscribe(topic, handler)
+	v := reflect.ValueOf(handler)
+	if v.Kind() != reflect.Func {
+		return
+	}
+
+	var removed *wrappedHandler
+	handlersMutex.Lock()
+	for i, h := range handlers[topic] {
+		if h.original.Pointer() == v.Pointer() {
+			removed = h
+			handlers[topic] = append(handlers[topic][:i], handlers[topic][i+1:]...)
+			break
+		}
+	}
+	handlersMutex.Unlock()
+	if removed == nil {
+		return
+	}
+
+	// the bus matches the exact wrapper subscribed, disabling it as well
+	// covers publishes already queued to run it
+	atomic.StoreInt32(&removed.removed, 1)
+	_ = bus.Unsubscribe(topic, removed.wrapper)
 }
 
 // Publish executes callback defined for a topic. Any additional argument will be transferred to the callback.
+// If any argument is being traced the handlers are instead executed synchronously and recorded on the trace.
 func Publish(topic string, args ...interface{}) {
+	if t := traceOf(args); t != nil {
+		publishTraced(t, topic, args)
+		return
+	}
+	start := time.Now()
 	bus.Publish(topic, args...)
+	publishDuration.WithLabelValues(topic).Observe(time.Since(start).Seconds())
+}
+
+// wrapHandler times each execution of fn
+func wrapHandler(topic string, fn interface{}, once bool) interface{} {
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func {
+		// let the bus report the error
+		return fn
+	}
+
+	h := &wrappedHandler{original: v, once: once}
+	observer := handlerDuration.WithLabelValues(topic)
+	h.wrapper = reflect.MakeFunc(v.Type(), func(args []reflect.Value) []reflect.Value {
+		if atomic.LoadInt32(&h.removed) == 1 {
+			return zeroResults(v.Type())
+		}
+		start := time.Now()
+		defer func() {
+			observer.Observe(time.Since(start).Seconds())
+		}()
+		return v.Call(args)
+	}).Interface()
+
+	handlersMutex.Lock()
+	handlers[topic] = append(handlers[topic], h)
+	handlersMutex.Unlock()
+	return h.wrapper
+}
+
+func zeroResults(t reflect.Type) []reflect.Value {
+	res := make([]reflect.Value, t.NumOut())
+	for i := range res {
+		res[i] = reflect.Zero(t.Out(i))
+	}
+	return res
+}
+
+type traceable interface {
+	GetTrace() *trace.Trace
+}
+
+func traceOf(args []interface{}) *trace.Trace {
+	for _, arg := range args {
+		if ta, ok := arg.(traceable); ok {
+			if t := ta.GetTrace(); t != nil {
+				return t
+			}
+		}
+	}
+	return nil
+}
+
+// publishTraced runs each handler of topic synchronously, recording them on t
+// once handlers are skipped as they are startup hooks rather than part of message processing
+func publishTraced(t *trace.Trace, topic string, args []interface{}) {
+	handlersMutex.Lock()
+	hs := make([]*wrappedHandler, 0, len(handlers[topic]))
+	for _, h := range handlers[topic] {
+		if !h.once && atomic.LoadInt32(&h.removed) == 0 {
+			hs = append(hs, h)
+		}
+	}
+	handlersMutex.Unlock()
+
+	t.Begin(&trace.Step{Kind: trace.KindPublish, Topic: topic})
+	defer t.End()
+	for _, h := range hs {
+		t.Begin(&trace.Step{Kind: trace.KindHandler, Handler: handlerName(h.original)})
+		callTraced(t, h.original, args)
+		t.End()
+	}
+}
+
+func callTraced(t *trace.Trace, fn reflect.Value, args []interface{}) {
+	defer func() {
+		if r := recover(); r != nil {
+			t.Add(&trace.Step{Kind: trace.KindPanic, Error: fmt.Sprint(r)})
+		}
+	}()
+
+	ft := fn.Type()
+	in := make([]reflect.Value, len(args))
+	for i, arg := range args {
+		if arg == nil && i < ft.NumIn() {
+			in[i] = reflect.Zero(ft.In(i))
+		} else {
+			in[i] = reflect.ValueOf(arg)
+		}
+	}
+	fn.Call(in)
+}
+
+func handlerName(fn reflect.Value) string {
+	if f := runtime.FuncForPC(fn.Pointer()); f != nil {
+		return f.Name()
+	}
+	return "unknown"
 }
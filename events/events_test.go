@@ -0,0 +1,72 @@
+package events
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/oipwg/oip/trace"
+)
+
+var firstCalls, secondCalls int32
+
+func first(n int)  { atomic.AddInt32(&firstCalls, int32(n)) }
+func second(n int) { atomic.AddInt32(&secondCalls, int32(n)) }
+
+func TestUnsubscribeWrapped(t *testing.T) {
+	SubscribeAsync("test:unsubscribe", first)
+	SubscribeAsync("test:unsubscribe", second)
+
+	Publish("test:unsubscribe", 1)
+	bus.WaitAsync()
+
+	Unsubscribe("test:unsubscribe", second)
+	Publish("test:unsubscribe", 1)
+	bus.WaitAsync()
+
+	if f := atomic.LoadInt32(&firstCalls); f != 2 {
+		t.Errorf("expected first handler to be called twice, called %d", f)
+	}
+	if s := atomic.LoadInt32(&secondCalls); s != 1 {
+		t.Errorf("expected second handler to be called once, called %d", s)
+	}
+
+	Unsubscribe("test:unsubscribe", first)
+	if bus.HasCallback("test:unsubscribe") {
+		t.Error("expected unsubscribed handlers to be removed from the bus")
+	}
+}
+
+type tracedArg struct {
+	t *trace.Trace
+}
+
+func (ta *tracedArg) GetTrace() *trace.Trace { return ta.t }
+
+func TestPublishTraced(t *testing.T) {
+	SubscribeAsync("test:traced:outer", func(ta *tracedArg) {
+		ta.t.Note("outer")
+		Publish("test:traced:inner", ta)
+	})
+	SubscribeAsync("test:traced:inner", func(ta *tracedArg) {
+		panic("inner failed")
+	})
+
+	tr := trace.New("txid")
+	Publish("test:traced:outer", &tracedArg{t: tr})
+
+	if len(tr.Steps) != 1 || tr.Steps[0].Kind != trace.KindPublish {
+		t.Fatalf("expected a single publish step, got %+v", tr.Steps)
+	}
+	outer := tr.Steps[0].Steps
+	if len(outer) != 1 || outer[0].Kind != trace.KindHandler {
+		t.Fatalf("expected a single handler, got %+v", outer)
+	}
+	steps := outer[0].Steps
+	if len(steps) != 2 || steps[0].Detail != "outer" || steps[1].Topic != "test:traced:inner" {
+		t.Fatalf("unexpected outer handler steps %+v", steps)
+	}
+	inner := steps[1].Steps
+	if len(inner) != 1 || len(inner[0].Steps) != 1 || inner[0].Steps[0].Kind != trace.KindPanic {
+		t.Errorf("expected inner handler panic to be recorded, got %+v", inner)
+	}
+}
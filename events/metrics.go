@@ -0,0 +1,25 @@
+package events
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/oipwg/oip/metrics"
+)
+
+var (
+	publishDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "events",
+		Name:      "publish_duration_seconds",
+		Help:      "Time spent publishing events, by topic",
+		Buckets:   prometheus.ExponentialBuckets(0.00005, 4, 10),
+	}, []string{"topic"})
+	handlerDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "events",
+		Name:      "handler_duration_seconds",
+		Help:      "Time spent executing event handlers, by topic",
+		Buckets:   prometheus.ExponentialBuckets(0.0005, 4, 10),
+	}, []string{"topic"})
+)
@@ -22,7 +22,8 @@ import (
 )
 
 var (
-	clients []*rpcclient.Client
+	clients     []*rpcclient.Client
+	clientHosts = make(map[*rpcclient.Client]string)
 )
 
 func AddCore(host, user, pass string) error {
@@ -35,6 +36,7 @@ func AddCore(host, user, pass string) error {
 	}
 	c, err := rpcclient.New(cfg, nil)
 	clients = append(clients, c)
+	clientHosts[c] = host
 	return err
 }
 
@@ -119,6 +121,7 @@ func AddFlod(host, user, pass string, tls bool) error {
 		return errors.Wrap(err, "unable to create new rpc client")
 	}
 	clients = append(clients, c)
+	clientHosts[c] = host
 	return nil
 }
 
@@ -137,9 +140,11 @@ func GetBlockCount() (blockCount int64, err error) {
 
 	if len(clients) == 1 {
 		blockCount, err = clients[0].GetBlockCount()
+		observeRpc(clients[0], "getblockcount", err)
 	} else {
 		for _, c := range clients {
 			blockCount, err = c.GetBlockCount()
+			observeRpc(c, "getblockcount", err)
 			if err == nil {
 				return
 			}
@@ -153,10 +158,16 @@ func BeginNotifyBlocks() (err error) {
 
 	if len(clients) == 1 {
 		err = clients[0].NotifyBlocks()
+		observeRpc(clients[0], "notifyblocks", err)
+		if err == nil {
+			setNotifying(&notifyBlocksClient, clients[0])
+		}
 	} else {
 		for _, c := range clients {
 			err = c.NotifyBlocks()
+			observeRpc(c, "notifyblocks", err)
 			if err == nil {
+				setNotifying(&notifyBlocksClient, c)
 				return
 			}
 		}
@@ -169,10 +180,16 @@ func BeginNotifyTransactions() (err error) {
 
 	if len(clients) == 1 {
 		err = clients[0].NotifyNewTransactions(true)
+		observeRpc(clients[0], "notifynewtransactions", err)
+		if err == nil {
+			setNotifying(&notifyTxsClient, clients[0])
+		}
 	} else {
 		for _, c := range clients {
 			err = c.NotifyNewTransactions(true)
+			observeRpc(c, "notifynewtransactions", err)
 			if err == nil {
+				setNotifying(&notifyTxsClient, c)
 				return
 			}
 		}
@@ -191,6 +208,7 @@ func GetBlockHash(i int64) (hash *chainhash.Hash, err error) {
 	err = errors.New("no clients connected")
 	for _, c := range clients {
 		hash, err = c.GetBlockHash(i)
+		observeRpc(c, "getblockhash", err)
 		if err == nil {
 			return
 		}
@@ -202,9 +220,11 @@ func GetBlockVerboseTx(hash *chainhash.Hash) (br *flojson.GetBlockVerboseResult,
 	err = errors.New("no clients connected")
 	if len(clients) == 1 {
 		br, err = clients[0].GetBlockVerboseTx(hash)
+		observeRpc(clients[0], "getblock", err)
 	} else {
 		for _, c := range clients {
 			br, err = c.GetBlockVerboseTx(hash)
+			observeRpc(c, "getblock", err)
 			if err == nil {
 				return
 			}
@@ -217,9 +237,11 @@ func GetTxVerbose(hash *chainhash.Hash) (tr *flojson.TxRawResult, err error) {
 	err = errors.New("no clients connected")
 	if len(clients) == 1 {
 		tr, err = clients[0].GetRawTransactionVerbose(hash)
+		observeRpc(clients[0], "getrawtransaction", err)
 	} else {
 		for _, c := range clients {
 			tr, err = c.GetRawTransactionVerbose(hash)
+			observeRpc(c, "getrawtransaction", err)
 			if err == nil {
 				return
 			}
@@ -0,0 +1,354 @@
+package flotest
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/bitspill/flod/chaincfg"
+	"github.com/bitspill/flod/chaincfg/chainhash"
+	"github.com/bitspill/flod/txscript"
+	"github.com/bitspill/flod/wire"
+	"github.com/bitspill/floutil"
+)
+
+const (
+	// time between mined blocks, matching the FLO target spacing
+	blockSpacing = 40 * time.Second
+	// value of the genesis output funding every transaction the chain creates
+	faucetValue   = 1000000 * floutil.SatoshiPerBitcoin
+	coinbaseValue = int64(12.5 * floutil.SatoshiPerBitcoin)
+	txFee         = 100000
+)
+
+// faucetScript is an anyone-can-spend output script; the chain never checks
+// signatures so it is enough to chain transactions together
+var faucetScript = []byte{txscript.OP_TRUE}
+
+// Block is a block of a Chain
+type Block struct {
+	Height int64
+	Hash   chainhash.Hash
+	Header wire.BlockHeader
+	Txs    []*Tx
+}
+
+// Tx is a transaction created by or sent to a Chain
+type Tx struct {
+	Hash chainhash.Hash
+	Msg  *wire.MsgTx
+}
+
+// FloData returns the floData of the transaction as a string
+func (tx *Tx) FloData() string {
+	return string(tx.Msg.FloData)
+}
+
+type eventKind int
+
+const (
+	blockConnected eventKind = iota
+	blockDisconnected
+	txAccepted
+)
+
+type event struct {
+	kind  eventKind
+	block *Block
+	tx    *Tx
+}
+
+// Chain is a scripted block chain with a mempool. Blocks are mined on demand
+// containing the given floData; proof of work, scripts and signatures are never
+// checked. Transactions of disconnected blocks return to the mempool and are
+// mined into the next block, as flod does.
+type Chain struct {
+	params *chaincfg.Params
+
+	mu       sync.Mutex
+	blocks   []*Block
+	byHash   map[chainhash.Hash]*Block
+	txs      map[chainhash.Hash]*Tx
+	txBlock  map[chainhash.Hash]*Block
+	mempool  []*Tx
+	faucet   wire.OutPoint
+	faucetV  int64
+	nextTime time.Time
+
+	// observers receive events in order; emitMu keeps concurrent mining in order
+	emitMu    sync.Mutex
+	observers []func(event)
+}
+
+// NewChain returns a chain for the given network holding only a genesis block
+func NewChain(params *chaincfg.Params) *Chain {
+	c := &Chain{
+		params:   params,
+		byHash:   make(map[chainhash.Hash]*Block),
+		txs:      make(map[chainhash.Hash]*Tx),
+		txBlock:  make(map[chainhash.Hash]*Block),
+		nextTime: time.Unix(1546300800, 0),
+	}
+
+	genesis := c.coinbase(0, nil)
+	genesis.Msg.TxOut = append(genesis.Msg.TxOut, &wire.TxOut{Value: faucetValue, PkScript: faucetScript})
+	genesis.Hash = genesis.Msg.TxHash()
+	c.faucet = wire.OutPoint{Hash: genesis.Hash, Index: 1}
+	c.faucetV = faucetValue
+	c.connect([]*Tx{genesis})
+
+	return c
+}
+
+// Params returns the network parameters addresses are encoded for
+func (c *Chain) Params() *chaincfg.Params {
+	return c.params
+}
+
+// Height returns the height of the chain tip
+func (c *Chain) Height() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return int64(len(c.blocks) - 1)
+}
+
+// Tip returns the block at the tip of the chain
+func (c *Chain) Tip() *Block {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.blocks[len(c.blocks)-1]
+}
+
+// BlockAt returns the main chain block at height, nil if there is none
+func (c *Chain) BlockAt(height int64) *Block {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if height < 0 || height >= int64(len(c.blocks)) {
+		return nil
+	}
+	return c.blocks[height]
+}
+
+// Send adds a transaction carrying floData to the mempool
+func (c *Chain) Send(floData string) *Tx {
+	c.mu.Lock()
+	tx := c.faucetTx(floData, nil)
+	c.mempool = append(c.mempool, tx)
+	c.mu.Unlock()
+
+	c.emit(event{kind: txAccepted, tx: tx})
+	return tx
+}
+
+// Fund adds a transaction paying amount to addr to the mempool
+func (c *Chain) Fund(addr floutil.Address, amount floutil.Amount) (*Tx, error) {
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	tx := c.faucetTx("", &wire.TxOut{Value: int64(amount), PkScript: pkScript})
+	c.mempool = append(c.mempool, tx)
+	c.mu.Unlock()
+
+	c.emit(event{kind: txAccepted, tx: tx})
+	return tx, nil
+}
+
+// Mine mines a block containing the mempool followed by one transaction for
+// each floData
+func (c *Chain) Mine(floData ...string) *Block {
+	return c.MineCoinbase("", floData...)
+}
+
+// MineCoinbase mines a block as Mine does with coinbaseData as the floData of
+// its coinbase transaction
+func (c *Chain) MineCoinbase(coinbaseData string, floData ...string) *Block {
+	c.mu.Lock()
+	b := c.mine(coinbaseData, floData)
+	c.mu.Unlock()
+
+	c.emit(event{kind: blockConnected, block: b})
+	return b
+}
+
+// Reorg disconnects the top depth blocks and replaces them with a branch of
+// len(branch) blocks, each containing the floData given for it. Disconnections
+// are notified tip first, followed by the connection of the new branch.
+func (c *Chain) Reorg(depth int, branch ...[]string) []*Block {
+	c.mu.Lock()
+	if depth >= len(c.blocks) {
+		c.mu.Unlock()
+		panic("flotest: reorg would disconnect the genesis block")
+	}
+
+	var events []event
+	var returned []*Tx
+	for i := 0; i < depth; i++ {
+		b := c.blocks[len(c.blocks)-1]
+		c.blocks = c.blocks[:len(c.blocks)-1]
+		var blockTxs []*Tx
+		for _, tx := range b.Txs {
+			delete(c.txBlock, tx.Hash)
+			if isCoinbase(tx.Msg) {
+				delete(c.txs, tx.Hash)
+				continue
+			}
+			blockTxs = append(blockTxs, tx)
+		}
+		returned = append(blockTxs, returned...)
+		events = append(events, event{kind: blockDisconnected, block: b})
+	}
+	// transactions of lower blocks were created first and must be mined first
+	c.mempool = append(returned, c.mempool...)
+
+	var mined []*Block
+	for _, floData := range branch {
+		b := c.mine("", floData)
+		mined = append(mined, b)
+		events = append(events, event{kind: blockConnected, block: b})
+	}
+	c.mu.Unlock()
+
+	c.emit(events...)
+	return mined
+}
+
+// accept adds a transaction received over rpc to the mempool
+func (c *Chain) accept(msg *wire.MsgTx) (*Tx, error) {
+	tx := &Tx{Hash: msg.TxHash(), Msg: msg}
+
+	c.mu.Lock()
+	if _, ok := c.txs[tx.Hash]; ok {
+		c.mu.Unlock()
+		return nil, errAlreadyHave
+	}
+	c.txs[tx.Hash] = tx
+	c.mempool = append(c.mempool, tx)
+	c.mu.Unlock()
+
+	c.emit(event{kind: txAccepted, tx: tx})
+	return tx, nil
+}
+
+func (c *Chain) observe(fn func(event)) {
+	c.emitMu.Lock()
+	c.observers = append(c.observers, fn)
+	c.emitMu.Unlock()
+}
+
+func (c *Chain) emit(events ...event) {
+	c.emitMu.Lock()
+	defer c.emitMu.Unlock()
+	for _, e := range events {
+		for _, fn := range c.observers {
+			fn(e)
+		}
+	}
+}
+
+// requires lock
+func (c *Chain) mine(coinbaseData string, floData []string) *Block {
+	height := int64(len(c.blocks))
+	txs := []*Tx{c.coinbase(height, []byte(coinbaseData))}
+	txs = append(txs, c.mempool...)
+	c.mempool = nil
+	for _, fd := range floData {
+		txs = append(txs, c.faucetTx(fd, nil))
+	}
+	return c.connect(txs)
+}
+
+// requires lock
+func (c *Chain) connect(txs []*Tx) *Block {
+	var prev chainhash.Hash
+	if len(c.blocks) > 0 {
+		prev = c.blocks[len(c.blocks)-1].Hash
+	}
+
+	// not a real merkle root, oipd never verifies it
+	var buf bytes.Buffer
+	for _, tx := range txs {
+		buf.Write(tx.Hash[:])
+	}
+
+	b := &Block{
+		Height: int64(len(c.blocks)),
+		Header: wire.BlockHeader{
+			Version:    0x20000000,
+			PrevBlock:  prev,
+			MerkleRoot: chainhash.DoubleHashH(buf.Bytes()),
+			Timestamp:  c.nextTime,
+			Bits:       0x1e0ffff0,
+			Nonce:      uint32(len(c.blocks)),
+		},
+		Txs: txs,
+	}
+	b.Hash = b.Header.BlockHash()
+	c.nextTime = c.nextTime.Add(blockSpacing)
+
+	c.blocks = append(c.blocks, b)
+	c.byHash[b.Hash] = b
+	for _, tx := range txs {
+		c.txs[tx.Hash] = tx
+		c.txBlock[tx.Hash] = b
+	}
+	return b
+}
+
+// requires lock
+func (c *Chain) coinbase(height int64, floData []byte) *Tx {
+	// the block time keeps coinbases of competing blocks at a height distinct
+	sigScript, _ := txscript.NewScriptBuilder().AddInt64(height).AddInt64(c.nextTime.Unix()).Script()
+	msg := &wire.MsgTx{
+		Version: 2,
+		TxIn: []*wire.TxIn{{
+			PreviousOutPoint: wire.OutPoint{Index: wire.MaxPrevOutIndex},
+			SignatureScript:  sigScript,
+			Sequence:         wire.MaxTxInSequenceNum,
+		}},
+		TxOut:   []*wire.TxOut{{Value: coinbaseValue, PkScript: faucetScript}},
+		FloData: floData,
+	}
+	return &Tx{Hash: msg.TxHash(), Msg: msg}
+}
+
+// faucetTx spends the faucet output, paying out to the optional output and the
+// remainder back to the faucet; requires lock
+func (c *Chain) faucetTx(floData string, out *wire.TxOut) *Tx {
+	msg := &wire.MsgTx{
+		Version: 2,
+		TxIn: []*wire.TxIn{{
+			PreviousOutPoint: c.faucet,
+			SignatureScript:  []byte{txscript.OP_TRUE},
+			Sequence:         wire.MaxTxInSequenceNum,
+		}},
+		FloData: []byte(floData),
+	}
+	change := c.faucetV - txFee
+	if out != nil {
+		change -= out.Value
+		msg.TxOut = append(msg.TxOut, out)
+	}
+	msg.TxOut = append(msg.TxOut, &wire.TxOut{Value: change, PkScript: faucetScript})
+
+	tx := &Tx{Hash: msg.TxHash(), Msg: msg}
+	c.txs[tx.Hash] = tx
+	c.faucet = wire.OutPoint{Hash: tx.Hash, Index: uint32(len(msg.TxOut) - 1)}
+	c.faucetV = change
+	return tx
+}
+
+func isCoinbase(msg *wire.MsgTx) bool {
+	return len(msg.TxIn) == 1 && msg.TxIn[0].PreviousOutPoint.Index == wire.MaxPrevOutIndex &&
+		msg.TxIn[0].PreviousOutPoint.Hash == chainhash.Hash{}
+}
+
+func serialize(msg interface{ Serialize(w io.Writer) error }) string {
+	var buf bytes.Buffer
+	_ = msg.Serialize(&buf)
+	return hex.EncodeToString(buf.Bytes())
+}
@@ -0,0 +1,5 @@
+package flotest
+
+import "github.com/azer/logger"
+
+var log = logger.New("flotest")
@@ -0,0 +1,531 @@
+package flotest
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bitspill/flod/chaincfg/chainhash"
+	"github.com/bitspill/flod/flojson"
+	"github.com/bitspill/flod/txscript"
+	"github.com/bitspill/flod/wire"
+	"github.com/bitspill/floutil"
+)
+
+var errAlreadyHave = flojson.NewRPCError(flojson.ErrRPCVerifyAlreadyInChain, "transaction already exists")
+
+type handler func(c *Chain, params []json.RawMessage) (interface{}, *flojson.RPCError)
+
+var handlers map[string]handler
+
+func init() {
+	handlers = map[string]handler{
+		"getbestblockhash":      handleGetBestBlockHash,
+		"getblock":              handleGetBlock,
+		"getblockcount":         handleGetBlockCount,
+		"getblockhash":          handleGetBlockHash,
+		"getinfo":               handleGetInfo,
+		"getrawtransaction":     handleGetRawTransaction,
+		"searchrawtransactions": handleSearchRawTransactions,
+		"sendrawtransaction":    handleSendRawTransaction,
+	}
+}
+
+// result types mirror the json of the flod rpc server
+
+type scriptSig struct {
+	Asm string `json:"asm"`
+	Hex string `json:"hex"`
+}
+
+type scriptPubKey struct {
+	Asm       string   `json:"asm"`
+	Hex       string   `json:"hex"`
+	ReqSigs   int32    `json:"reqSigs,omitempty"`
+	Type      string   `json:"type"`
+	Addresses []string `json:"addresses,omitempty"`
+}
+
+type vin struct {
+	Coinbase  string     `json:"coinbase,omitempty"`
+	Txid      string     `json:"txid,omitempty"`
+	Vout      uint32     `json:"vout"`
+	ScriptSig *scriptSig `json:"scriptSig,omitempty"`
+	PrevOut   *prevOut   `json:"prevOut,omitempty"`
+	Sequence  uint32     `json:"sequence"`
+}
+
+type prevOut struct {
+	Addresses []string `json:"addresses,omitempty"`
+	Value     float64  `json:"value"`
+}
+
+type vout struct {
+	Value        float64      `json:"value"`
+	N            uint32       `json:"n"`
+	ScriptPubKey scriptPubKey `json:"scriptPubKey"`
+}
+
+type txResult struct {
+	Hex           string `json:"hex"`
+	Txid          string `json:"txid"`
+	Hash          string `json:"hash"`
+	Version       int32  `json:"version"`
+	LockTime      uint32 `json:"locktime"`
+	Vin           []vin  `json:"vin"`
+	Vout          []vout `json:"vout"`
+	FloData       string `json:"floData"`
+	BlockHash     string `json:"blockhash,omitempty"`
+	Confirmations uint64 `json:"confirmations,omitempty"`
+	Time          int64  `json:"time,omitempty"`
+	Blocktime     int64  `json:"blocktime,omitempty"`
+}
+
+type blockResult struct {
+	Hash          string     `json:"hash"`
+	Confirmations int64      `json:"confirmations"`
+	Size          int        `json:"size"`
+	Height        int64      `json:"height"`
+	Version       int32      `json:"version"`
+	VersionHex    string     `json:"versionHex"`
+	MerkleRoot    string     `json:"merkleroot"`
+	Tx            []string   `json:"tx,omitempty"`
+	RawTx         []txResult `json:"rawtx,omitempty"`
+	Time          int64      `json:"time"`
+	Nonce         uint32     `json:"nonce"`
+	Bits          string     `json:"bits"`
+	Difficulty    float64    `json:"difficulty"`
+	PreviousHash  string     `json:"previousblockhash,omitempty"`
+	NextHash      string     `json:"nextblockhash,omitempty"`
+}
+
+func handleGetBlockCount(c *Chain, params []json.RawMessage) (interface{}, *flojson.RPCError) {
+	return c.Height(), nil
+}
+
+// getinfo is only partially filled, clients call it to detect the node type
+func handleGetInfo(c *Chain, params []json.RawMessage) (interface{}, *flojson.RPCError) {
+	return map[string]interface{}{
+		"version":         120000,
+		"protocolversion": wire.ProtocolVersion,
+		"blocks":          c.Height(),
+		"testnet":         c.params.Net != wire.MainNet,
+		"errors":          "",
+	}, nil
+}
+
+func handleGetBestBlockHash(c *Chain, params []json.RawMessage) (interface{}, *flojson.RPCError) {
+	return c.Tip().Hash.String(), nil
+}
+
+func handleGetBlockHash(c *Chain, params []json.RawMessage) (interface{}, *flojson.RPCError) {
+	var height int64
+	if err := param(params, 0, &height); err != nil {
+		return nil, err
+	}
+	b := c.BlockAt(height)
+	if b == nil {
+		return nil, rpcError(flojson.ErrRPCOutOfRange, "Block number out of range")
+	}
+	return b.Hash.String(), nil
+}
+
+// getblock accepts both [hash, verbose, verboseTx] and [hash, verbosity]
+func handleGetBlock(c *Chain, params []json.RawMessage) (interface{}, *flojson.RPCError) {
+	hash, err := hashParam(params, 0)
+	if err != nil {
+		return nil, err
+	}
+	verbosity := 1
+	if len(params) > 1 {
+		var v interface{}
+		if err := param(params, 1, &v); err != nil {
+			return nil, err
+		}
+		switch v := v.(type) {
+		case bool:
+			if !v {
+				verbosity = 0
+			} else if len(params) > 2 {
+				var verboseTx bool
+				if err := param(params, 2, &verboseTx); err != nil {
+					return nil, err
+				}
+				if verboseTx {
+					verbosity = 2
+				}
+			}
+		case float64:
+			verbosity = int(v)
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, ok := c.byHash[*hash]
+	if !ok {
+		return nil, rpcError(flojson.ErrRPCBlockNotFound, "Block not found")
+	}
+
+	msg := wire.NewMsgBlock(&b.Header)
+	for _, tx := range b.Txs {
+		_ = msg.AddTransaction(tx.Msg)
+	}
+	if verbosity == 0 {
+		return serialize(msg), nil
+	}
+
+	res := blockResult{
+		Hash:          b.Hash.String(),
+		Confirmations: c.confirmations(b),
+		Size:          msg.SerializeSize(),
+		Height:        b.Height,
+		Version:       b.Header.Version,
+		VersionHex:    fmt.Sprintf("%08x", b.Header.Version),
+		MerkleRoot:    b.Header.MerkleRoot.String(),
+		Time:          b.Header.Timestamp.Unix(),
+		Nonce:         b.Header.Nonce,
+		Bits:          fmt.Sprintf("%08x", b.Header.Bits),
+		Difficulty:    1,
+	}
+	if b.Height > 0 {
+		res.PreviousHash = b.Header.PrevBlock.String()
+	}
+	if res.Confirmations > 1 {
+		res.NextHash = c.blocks[b.Height+1].Hash.String()
+	}
+	for _, tx := range b.Txs {
+		if verbosity > 1 {
+			res.RawTx = append(res.RawTx, c.lockedTxResult(tx, nil))
+		} else {
+			res.Tx = append(res.Tx, tx.Hash.String())
+		}
+	}
+	return res, nil
+}
+
+func handleGetRawTransaction(c *Chain, params []json.RawMessage) (interface{}, *flojson.RPCError) {
+	hash, err := hashParam(params, 0)
+	if err != nil {
+		return nil, err
+	}
+	verbose, err := flagParam(params, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	tx := c.txs[*hash]
+	if tx == nil || (c.txBlock[*hash] == nil && !c.inMempool(tx)) {
+		return nil, rpcError(flojson.ErrRPCNoTxInfo, "No information available about transaction %v", hash)
+	}
+	if !verbose {
+		return serialize(tx.Msg), nil
+	}
+	return c.lockedTxResult(tx, nil), nil
+}
+
+func handleSendRawTransaction(c *Chain, params []json.RawMessage) (interface{}, *flojson.RPCError) {
+	var s string
+	if err := param(params, 0, &s); err != nil {
+		return nil, err
+	}
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, rpcError(flojson.ErrRPCDeserialization, "TX decode failed: %v", err)
+	}
+	msg := wire.NewMsgTx(wire.TxVersion)
+	if err := msg.Deserialize(bytes.NewReader(b)); err != nil {
+		return nil, rpcError(flojson.ErrRPCDeserialization, "TX decode failed: %v", err)
+	}
+
+	tx, err := c.accept(msg)
+	if err != nil {
+		return nil, err.(*flojson.RPCError)
+	}
+	return tx.Hash.String(), nil
+}
+
+// searchrawtransactions returns main chain transactions followed by the mempool
+// which pay to or spend from the address, with vin and vout limited to
+// filteraddrs when given
+func handleSearchRawTransactions(c *Chain, params []json.RawMessage) (interface{}, *flojson.RPCError) {
+	var address string
+	rerr := param(params, 0, &address)
+	if rerr != nil {
+		return nil, rerr
+	}
+	addr, err := floutil.DecodeAddress(address, c.params)
+	if err != nil {
+		return nil, rpcError(flojson.ErrRPCInvalidAddressOrKey, "Invalid address or key: %v", err)
+	}
+	verbose := true
+	if len(params) > 1 {
+		if verbose, rerr = flagParam(params, 1); rerr != nil {
+			return nil, rerr
+		}
+	}
+	skip, count := 0, 100
+	var reverse bool
+	var filterAddrs []string
+	for i, p := range map[int]interface{}{2: &skip, 3: &count, 5: &reverse, 6: &filterAddrs} {
+		if rerr = param(params, i, p); rerr != nil {
+			return nil, rerr
+		}
+	}
+	vinExtra, rerr := flagParam(params, 4)
+	if rerr != nil {
+		return nil, rerr
+	}
+	filter := make(map[string]struct{}, len(filterAddrs))
+	for _, a := range filterAddrs {
+		filter[a] = struct{}{}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var matches []*Tx
+	for _, b := range c.blocks {
+		for _, tx := range b.Txs {
+			if c.involves(tx, addr.EncodeAddress()) {
+				matches = append(matches, tx)
+			}
+		}
+	}
+	for _, tx := range c.mempool {
+		if c.involves(tx, addr.EncodeAddress()) {
+			matches = append(matches, tx)
+		}
+	}
+	if reverse {
+		for i, j := 0, len(matches)-1; i < j; i, j = i+1, j-1 {
+			matches[i], matches[j] = matches[j], matches[i]
+		}
+	}
+	if skip > len(matches) {
+		skip = len(matches)
+	}
+	matches = matches[skip:]
+	if count >= 0 && count < len(matches) {
+		matches = matches[:count]
+	}
+	if len(matches) == 0 {
+		return nil, rpcError(flojson.ErrRPCNoTxInfo, "No information available about address")
+	}
+
+	if !verbose {
+		res := make([]string, len(matches))
+		for i, tx := range matches {
+			res[i] = serialize(tx.Msg)
+		}
+		return res, nil
+	}
+
+	res := make([]txResult, 0, len(matches))
+	for _, tx := range matches {
+		r := c.lockedTxResult(tx, filter)
+		if !vinExtra {
+			for i := range r.Vin {
+				r.Vin[i].PrevOut = nil
+			}
+		}
+		res = append(res, r)
+	}
+	return res, nil
+}
+
+// txResult builds the verbose json of tx
+func (c *Chain) txResult(tx *Tx) txResult {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lockedTxResult(tx, nil)
+}
+
+// lockedTxResult builds the verbose json of tx, when filter is not empty only
+// inputs and outputs involving its addresses are included; requires lock
+func (c *Chain) lockedTxResult(tx *Tx, filter map[string]struct{}) txResult {
+	res := txResult{
+		Hex:      serialize(tx.Msg),
+		Txid:     tx.Hash.String(),
+		Hash:     tx.Msg.WitnessHash().String(),
+		Version:  tx.Msg.Version,
+		LockTime: tx.Msg.LockTime,
+		FloData:  string(tx.Msg.FloData),
+		Vin:      []vin{},
+		Vout:     []vout{},
+	}
+	if b := c.txBlock[tx.Hash]; b != nil {
+		res.BlockHash = b.Hash.String()
+		res.Confirmations = uint64(c.confirmations(b))
+		res.Time = b.Header.Timestamp.Unix()
+		res.Blocktime = res.Time
+	}
+
+	for _, in := range tx.Msg.TxIn {
+		if isCoinbase(tx.Msg) {
+			res.Vin = append(res.Vin, vin{
+				Coinbase: hex.EncodeToString(in.SignatureScript),
+				Sequence: in.Sequence,
+			})
+			continue
+		}
+		asm, _ := txscript.DisasmString(in.SignatureScript)
+		v := vin{
+			Txid:      in.PreviousOutPoint.Hash.String(),
+			Vout:      in.PreviousOutPoint.Index,
+			ScriptSig: &scriptSig{Asm: asm, Hex: hex.EncodeToString(in.SignatureScript)},
+			Sequence:  in.Sequence,
+		}
+		if prev := c.txs[in.PreviousOutPoint.Hash]; prev != nil && int(in.PreviousOutPoint.Index) < len(prev.Msg.TxOut) {
+			out := prev.Msg.TxOut[in.PreviousOutPoint.Index]
+			v.PrevOut = &prevOut{
+				Addresses: c.addresses(out.PkScript),
+				Value:     float64(out.Value) / floutil.SatoshiPerBitcoin,
+			}
+		}
+		if len(filter) > 0 && (v.PrevOut == nil || !anyIn(v.PrevOut.Addresses, filter)) {
+			continue
+		}
+		res.Vin = append(res.Vin, v)
+	}
+
+	for i, out := range tx.Msg.TxOut {
+		asm, _ := txscript.DisasmString(out.PkScript)
+		class, addrs, reqSigs, _ := txscript.ExtractPkScriptAddrs(out.PkScript, c.params)
+		v := vout{
+			Value: float64(out.Value) / floutil.SatoshiPerBitcoin,
+			N:     uint32(i),
+			ScriptPubKey: scriptPubKey{
+				Asm:     asm,
+				Hex:     hex.EncodeToString(out.PkScript),
+				ReqSigs: int32(reqSigs),
+				Type:    class.String(),
+			},
+		}
+		for _, a := range addrs {
+			v.ScriptPubKey.Addresses = append(v.ScriptPubKey.Addresses, a.EncodeAddress())
+		}
+		if len(filter) > 0 && !anyIn(v.ScriptPubKey.Addresses, filter) {
+			continue
+		}
+		res.Vout = append(res.Vout, v)
+	}
+	return res
+}
+
+// involves reports whether tx pays to or spends from addr; requires lock
+func (c *Chain) involves(tx *Tx, addr string) bool {
+	for _, out := range tx.Msg.TxOut {
+		for _, a := range c.addresses(out.PkScript) {
+			if a == addr {
+				return true
+			}
+		}
+	}
+	if isCoinbase(tx.Msg) {
+		return false
+	}
+	for _, in := range tx.Msg.TxIn {
+		prev := c.txs[in.PreviousOutPoint.Hash]
+		if prev == nil || int(in.PreviousOutPoint.Index) >= len(prev.Msg.TxOut) {
+			continue
+		}
+		for _, a := range c.addresses(prev.Msg.TxOut[in.PreviousOutPoint.Index].PkScript) {
+			if a == addr {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (c *Chain) addresses(pkScript []byte) []string {
+	_, addrs, _, _ := txscript.ExtractPkScriptAddrs(pkScript, c.params)
+	var res []string
+	for _, a := range addrs {
+		res = append(res, a.EncodeAddress())
+	}
+	return res
+}
+
+// confirmations of b, -1 once it has been reorganized out; requires lock
+func (c *Chain) confirmations(b *Block) int64 {
+	if b.Height >= int64(len(c.blocks)) || c.blocks[b.Height] != b {
+		return -1
+	}
+	return int64(len(c.blocks)) - b.Height
+}
+
+// requires lock
+func (c *Chain) inMempool(tx *Tx) bool {
+	for _, m := range c.mempool {
+		if m == tx {
+			return true
+		}
+	}
+	return false
+}
+
+func anyIn(addrs []string, set map[string]struct{}) bool {
+	for _, a := range addrs {
+		if _, ok := set[a]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func outputValue(msg *wire.MsgTx) int64 {
+	var v int64
+	for _, out := range msg.TxOut {
+		v += out.Value
+	}
+	return v
+}
+
+// param decodes the optional parameter i into v, leaving v untouched when it is
+// absent or null
+func param(params []json.RawMessage, i int, v interface{}) *flojson.RPCError {
+	if i >= len(params) || string(params[i]) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(params[i], v); err != nil {
+		return rpcError(flojson.ErrRPCInvalidParameter, "invalid parameter %d: %v", i+1, err)
+	}
+	return nil
+}
+
+// flagParam decodes a parameter sent either as a bool or as an int
+func flagParam(params []json.RawMessage, i int) (bool, *flojson.RPCError) {
+	var v interface{}
+	if err := param(params, i, &v); err != nil {
+		return false, err
+	}
+	switch v := v.(type) {
+	case bool:
+		return v, nil
+	case float64:
+		return v != 0, nil
+	}
+	return false, nil
+}
+
+func hashParam(params []json.RawMessage, i int) (*chainhash.Hash, *flojson.RPCError) {
+	var s string
+	if i >= len(params) {
+		return nil, rpcError(flojson.ErrRPCInvalidParameter, "missing parameter %d", i+1)
+	}
+	if err := param(params, i, &s); err != nil {
+		return nil, err
+	}
+	hash, err := chainhash.NewHashFromStr(s)
+	if err != nil {
+		return nil, rpcError(flojson.ErrRPCDeserialization, "invalid hash %q: %v", s, err)
+	}
+	return hash, nil
+}
@@ -0,0 +1,260 @@
+package flotest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/azer/logger"
+	"github.com/bitspill/flod/flojson"
+	"github.com/bitspill/floutil"
+	"github.com/btcsuite/websocket"
+)
+
+const (
+	User = "flotest"
+	Pass = "flotest"
+)
+
+// Server serves a Chain over the subset of the flod JSON-RPC api used by oipd,
+// both as http POST requests and on the /ws websocket endpoint along with the
+// block and transaction notifications.
+type Server struct {
+	Chain *Chain
+
+	srv *httptest.Server
+
+	mu      sync.Mutex
+	clients map[*wsClient]struct{}
+}
+
+type wsClient struct {
+	conn *websocket.Conn
+
+	// guards writes to conn and the subscriptions
+	mu           sync.Mutex
+	notifyBlocks bool
+	notifyTxs    bool
+	verboseTxs   bool
+}
+
+type request struct {
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+	ID     json.RawMessage   `json:"id"`
+}
+
+type response struct {
+	Result interface{}       `json:"result"`
+	Error  *flojson.RPCError `json:"error"`
+	ID     json.RawMessage   `json:"id"`
+}
+
+type notification struct {
+	Jsonrpc string        `json:"jsonrpc"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+	ID      interface{}   `json:"id"`
+}
+
+// NewServer starts serving chain on a local port; connect with
+// flo.AddFlod(s.Host(), flotest.User, flotest.Pass, false)
+func NewServer(chain *Chain) *Server {
+	s := &Server{
+		Chain:   chain,
+		clients: make(map[*wsClient]struct{}),
+	}
+	chain.observe(s.notify)
+	s.srv = httptest.NewServer(s)
+	return s
+}
+
+// Host returns the host:port the server is listening on
+func (s *Server) Host() string {
+	return strings.TrimPrefix(s.srv.URL, "http://")
+}
+
+// Close disconnects all websocket clients and stops the server
+func (s *Server) Close() {
+	s.mu.Lock()
+	for c := range s.clients {
+		_ = c.conn.Close()
+	}
+	s.mu.Unlock()
+	s.srv.Close()
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	user, pass, ok := r.BasicAuth()
+	if !ok || user != User || pass != Pass {
+		http.Error(w, "401 Unauthorized.", http.StatusUnauthorized)
+		return
+	}
+
+	if r.URL.Path == "/ws" {
+		s.serveWebsocket(w, r)
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		http.Error(w, "405 Method Not Allowed.", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "400 Bad Request.", http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(s.handle(nil, body))
+}
+
+func (s *Server) serveWebsocket(w http.ResponseWriter, r *http.Request) {
+	conn, err := websocket.Upgrade(w, r, nil, 0, 0)
+	if err != nil {
+		http.Error(w, "400 Bad Request.", http.StatusBadRequest)
+		return
+	}
+
+	c := &wsClient{conn: conn}
+	s.mu.Lock()
+	s.clients[c] = struct{}{}
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.clients, c)
+		s.mu.Unlock()
+		_ = conn.Close()
+	}()
+
+	for {
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		res := s.handle(c, msg)
+		c.mu.Lock()
+		err = conn.WriteMessage(websocket.TextMessage, res)
+		c.mu.Unlock()
+		if err != nil {
+			log.Error("websocket write failed", logger.Attrs{"err": err})
+			return
+		}
+	}
+}
+
+// handle executes a single request; c is nil for http POST requests
+func (s *Server) handle(c *wsClient, msg []byte) []byte {
+	var req request
+	var res response
+	if err := json.Unmarshal(msg, &req); err != nil {
+		res.Error = flojson.NewRPCError(flojson.ErrRPCParse.Code, "Failed to parse request: "+err.Error())
+	} else {
+		res.ID = req.ID
+		res.Result, res.Error = s.dispatch(c, &req)
+	}
+	if res.ID == nil {
+		res.ID = json.RawMessage("null")
+	}
+
+	b, err := json.Marshal(res)
+	if err != nil {
+		b, _ = json.Marshal(response{
+			Error: flojson.NewRPCError(flojson.ErrRPCInternal.Code, err.Error()),
+			ID:    res.ID,
+		})
+	}
+	return b
+}
+
+func (s *Server) dispatch(c *wsClient, req *request) (interface{}, *flojson.RPCError) {
+	switch req.Method {
+	case "notifyblocks", "stopnotifyblocks", "notifynewtransactions", "stopnotifynewtransactions":
+		if c == nil {
+			return nil, flojson.NewRPCError(flojson.ErrRPCMisc, "notifications require a websocket connection")
+		}
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		switch req.Method {
+		case "notifyblocks":
+			c.notifyBlocks = true
+		case "stopnotifyblocks":
+			c.notifyBlocks = false
+		case "notifynewtransactions":
+			c.notifyTxs = true
+			c.verboseTxs = false
+			if len(req.Params) > 0 {
+				_ = json.Unmarshal(req.Params[0], &c.verboseTxs)
+			}
+		case "stopnotifynewtransactions":
+			c.notifyTxs = false
+		}
+		return nil, nil
+	}
+
+	h, ok := handlers[req.Method]
+	if !ok {
+		return nil, flojson.ErrRPCMethodNotFound
+	}
+	return h(s.Chain, req.Params)
+}
+
+// notify sends chain events to the websocket clients subscribed to them
+func (s *Server) notify(e event) {
+	var n notification
+	var verboseTx interface{}
+	switch e.kind {
+	case blockConnected:
+		n = notification{Method: "filteredblockconnected", Params: []interface{}{e.block.Height, serialize(&e.block.Header), []string{}}}
+	case blockDisconnected:
+		n = notification{Method: "filteredblockdisconnected", Params: []interface{}{e.block.Height, serialize(&e.block.Header)}}
+	case txAccepted:
+		n = notification{Method: "txaccepted", Params: []interface{}{e.tx.Hash.String(), float64(outputValue(e.tx.Msg)) / floutil.SatoshiPerBitcoin}}
+		verboseTx = s.Chain.txResult(e.tx)
+	}
+	n.Jsonrpc = "1.0"
+
+	b, err := json.Marshal(n)
+	if err != nil {
+		log.Error("unable to marshal notification", logger.Attrs{"err": err, "method": n.Method})
+		return
+	}
+	var vb []byte
+	if verboseTx != nil {
+		vb, err = json.Marshal(notification{Jsonrpc: "1.0", Method: "txacceptedverbose", Params: []interface{}{verboseTx}})
+		if err != nil {
+			log.Error("unable to marshal notification", logger.Attrs{"err": err, "method": "txacceptedverbose"})
+			return
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for c := range s.clients {
+		c.mu.Lock()
+		msg := b
+		switch {
+		case e.kind == txAccepted && !c.notifyTxs:
+			msg = nil
+		case e.kind == txAccepted && c.verboseTxs:
+			msg = vb
+		case e.kind != txAccepted && !c.notifyBlocks:
+			msg = nil
+		}
+		if msg != nil {
+			if err := c.conn.WriteMessage(websocket.TextMessage, msg); err != nil {
+				log.Error("websocket notification failed", logger.Attrs{"err": err, "method": n.Method})
+			}
+		}
+		c.mu.Unlock()
+	}
+}
+
+func rpcError(code flojson.RPCErrorCode, format string, args ...interface{}) *flojson.RPCError {
+	return flojson.NewRPCError(code, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,243 @@
+package flotest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bitspill/flod/chaincfg"
+	"github.com/bitspill/flod/flojson"
+	"github.com/bitspill/flod/rpcclient"
+	"github.com/bitspill/flod/txscript"
+	"github.com/bitspill/flod/wire"
+	"github.com/bitspill/floutil"
+)
+
+type notified struct {
+	connected    chan int32
+	disconnected chan int32
+	txs          chan *flojson.TxRawResult
+}
+
+func connect(t *testing.T, s *Server) (*rpcclient.Client, *notified) {
+	n := &notified{
+		connected:    make(chan int32, 16),
+		disconnected: make(chan int32, 16),
+		txs:          make(chan *flojson.TxRawResult, 16),
+	}
+	c, err := rpcclient.New(&rpcclient.ConnConfig{
+		Host:       s.Host(),
+		Endpoint:   "ws",
+		User:       User,
+		Pass:       Pass,
+		DisableTLS: true,
+	}, &rpcclient.NotificationHandlers{
+		OnFilteredBlockConnected: func(height int32, header *wire.BlockHeader, txns []*floutil.Tx) {
+			n.connected <- height
+		},
+		OnFilteredBlockDisconnected: func(height int32, header *wire.BlockHeader) {
+			n.disconnected <- height
+		},
+		OnTxAcceptedVerbose: func(txDetails *flojson.TxRawResult) {
+			n.txs <- txDetails
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.NotifyBlocks(); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.NotifyNewTransactions(true); err != nil {
+		t.Fatal(err)
+	}
+	return c, n
+}
+
+func expectHeight(t *testing.T, ch chan int32, want int32) {
+	t.Helper()
+	select {
+	case h := <-ch:
+		if h != want {
+			t.Errorf("notified height %d, want %d", h, want)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no notification for height %d", want)
+	}
+}
+
+func TestMine(t *testing.T) {
+	s := NewServer(NewChain(&chaincfg.TestNet3Params))
+	defer s.Close()
+	c, n := connect(t, s)
+	defer c.Shutdown()
+
+	sent := s.Chain.Send("in the mempool")
+	select {
+	case tx := <-n.txs:
+		if tx.Txid != sent.Hash.String() || tx.FloData != "in the mempool" {
+			t.Errorf("unexpected tx notification %s %q", tx.Txid, tx.FloData)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no tx notification")
+	}
+
+	b := s.Chain.MineCoinbase("coinbase", "mined directly")
+	expectHeight(t, n.connected, 1)
+
+	count, err := c.GetBlockCount()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("block count %d, want 1", count)
+	}
+	hash, err := c.GetBlockHash(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *hash != b.Hash {
+		t.Errorf("block hash %s, want %s", hash, b.Hash)
+	}
+
+	br, err := c.GetBlockVerboseTx(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var floData []string
+	for _, tx := range br.RawTx {
+		floData = append(floData, tx.FloData)
+	}
+	if len(floData) != 3 || floData[0] != "coinbase" || floData[1] != "in the mempool" || floData[2] != "mined directly" {
+		t.Errorf("unexpected block floData %q", floData)
+	}
+	if !br.RawTx[0].Vin[0].IsCoinBase() {
+		t.Error("first transaction is not a coinbase")
+	}
+
+	tr, err := c.GetRawTransactionVerbose(&sent.Hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tr.BlockHash != b.Hash.String() || tr.Confirmations != 1 {
+		t.Errorf("tx in block %s with %d confirmations", tr.BlockHash, tr.Confirmations)
+	}
+
+	if _, err := c.GetBlockHash(2); err == nil {
+		t.Error("expected error for block beyond tip")
+	}
+
+	post, err := rpcclient.New(&rpcclient.ConnConfig{
+		Host:         s.Host(),
+		User:         User,
+		Pass:         Pass,
+		DisableTLS:   true,
+		HTTPPostMode: true,
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer post.Shutdown()
+	if count, err := post.GetBlockCount(); err != nil || count != 1 {
+		t.Errorf("http post block count %d err %v", count, err)
+	}
+}
+
+func TestReorg(t *testing.T) {
+	s := NewServer(NewChain(&chaincfg.TestNet3Params))
+	defer s.Close()
+	c, n := connect(t, s)
+	defer c.Shutdown()
+
+	s.Chain.Mine("one")
+	orphan := s.Chain.Mine("two")
+	s.Chain.Mine("three")
+	for h := int32(1); h <= 3; h++ {
+		expectHeight(t, n.connected, h)
+	}
+
+	branch := s.Chain.Reorg(2, []string{"two b"}, []string{"three b"}, []string{"four b"})
+	expectHeight(t, n.disconnected, 3)
+	expectHeight(t, n.disconnected, 2)
+	for h := int32(2); h <= 4; h++ {
+		expectHeight(t, n.connected, h)
+	}
+
+	// transactions of the disconnected blocks are mined again first
+	var floData []string
+	for _, tx := range branch[0].Txs[1:] {
+		floData = append(floData, tx.FloData())
+	}
+	if len(floData) != 3 || floData[0] != "two" || floData[1] != "three" || floData[2] != "two b" {
+		t.Errorf("unexpected reorg block floData %q", floData)
+	}
+
+	br, err := c.GetBlockVerbose(&orphan.Hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if br.Confirmations != -1 {
+		t.Errorf("orphaned block has %d confirmations", br.Confirmations)
+	}
+	hash, err := c.GetBlockHash(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *hash != branch[0].Hash {
+		t.Errorf("block hash %s, want %s", hash, branch[0].Hash)
+	}
+}
+
+func TestSendRawTransaction(t *testing.T) {
+	s := NewServer(NewChain(&chaincfg.TestNet3Params))
+	defer s.Close()
+	c, _ := connect(t, s)
+	defer c.Shutdown()
+
+	addr, err := floutil.NewAddressPubKeyHash(make([]byte, 20), s.Chain.Params())
+	if err != nil {
+		t.Fatal(err)
+	}
+	funding, err := s.Chain.Fund(addr, floutil.Amount(floutil.SatoshiPerBitcoin))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Chain.Mine()
+
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := wire.NewMsgTx(2)
+	msg.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&funding.Hash, 0), nil, nil))
+	msg.AddTxOut(wire.NewTxOut(floutil.SatoshiPerBitcoin/2, pkScript))
+	msg.FloData = []byte("sent over rpc")
+	hash, err := c.SendRawTransaction(msg, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *hash != msg.TxHash() {
+		t.Errorf("sent %s, want %s", hash, msg.TxHash())
+	}
+	if _, err := c.SendRawTransaction(msg, false); err == nil {
+		t.Error("expected error resending transaction")
+	}
+
+	res, err := c.SearchRawTransactionsVerbose(addr, 0, 100, false, false, []string{addr.String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 2 {
+		t.Fatalf("found %d transactions, want 2", len(res))
+	}
+	if res[0].Txid != funding.Hash.String() || res[0].Confirmations != 1 || len(res[0].Vin) != 0 || len(res[0].Vout) != 1 {
+		t.Errorf("unexpected funding transaction %+v", res[0])
+	}
+	if res[1].Txid != hash.String() || res[1].Confirmations != 0 || len(res[1].Vin) != 1 || res[1].FloData != "sent over rpc" {
+		t.Errorf("unexpected sent transaction %+v", res[1])
+	}
+
+	b := s.Chain.Mine()
+	if len(b.Txs) != 2 || b.Txs[1].Hash != *hash {
+		t.Error("sent transaction not mined")
+	}
+}
@@ -0,0 +1,55 @@
+package flo
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/bitspill/flod/rpcclient"
+	"github.com/pkg/errors"
+
+	"github.com/oipwg/oip/health"
+)
+
+// clients which accepted the notification subscriptions
+var (
+	notifyMutex        sync.Mutex
+	notifyBlocksClient *rpcclient.Client
+	notifyTxsClient    *rpcclient.Client
+)
+
+func init() {
+	health.Register("flod", checkFlod)
+}
+
+func setNotifying(target **rpcclient.Client, c *rpcclient.Client) {
+	notifyMutex.Lock()
+	*target = c
+	notifyMutex.Unlock()
+}
+
+// checkFlod verifies flod responds to rpc calls and the notification subscriptions are connected
+func checkFlod(ctx context.Context) (string, error) {
+	if len(clients) == 0 {
+		return "", errors.New("no clients connected")
+	}
+	count, err := GetBlockCount()
+	if err != nil {
+		return "", errors.Wrap(err, "getblockcount failed")
+	}
+
+	notifyMutex.Lock()
+	blocks, txs := notifyBlocksClient, notifyTxsClient
+	notifyMutex.Unlock()
+	if blocks == nil {
+		return "", errors.New("not subscribed to block notifications")
+	}
+	if txs == nil {
+		return "", errors.New("not subscribed to transaction notifications")
+	}
+	if blocks.Disconnected() || txs.Disconnected() {
+		return "", errors.New("notification websocket disconnected")
+	}
+
+	return fmt.Sprintf("block count %d", count), nil
+}
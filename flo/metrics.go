@@ -0,0 +1,32 @@
+package flo
+
+import (
+	"github.com/bitspill/flod/rpcclient"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/oipwg/oip/metrics"
+)
+
+var (
+	rpcCalls = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "flo",
+		Name:      "rpc_calls_total",
+		Help:      "RPC calls made to flod, by client and method",
+	}, []string{"client", "method"})
+	rpcErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "flo",
+		Name:      "rpc_errors_total",
+		Help:      "Failed RPC calls made to flod, by client and method",
+	}, []string{"client", "method"})
+)
+
+func observeRpc(c *rpcclient.Client, method string, err error) {
+	host := clientHosts[c]
+	rpcCalls.WithLabelValues(host, method).Inc()
+	if err != nil {
+		rpcErrors.WithLabelValues(host, method).Inc()
+	}
+}
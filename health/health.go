@@ -0,0 +1,141 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/azer/logger"
+	"github.com/spf13/viper"
+)
+
+// Check reports the state of a dependency
+// A non-nil error marks the daemon as not ready, detail describes a healthy state
+type Check func(ctx context.Context) (detail string, err error)
+
+var (
+	checksMutex sync.RWMutex
+	checks      = make(map[string]Check)
+)
+
+// Register adds a named readiness check, replacing any check of the same name
+func Register(name string, check Check) {
+	checksMutex.Lock()
+	checks[name] = check
+	checksMutex.Unlock()
+}
+
+// Result of a single readiness check
+type Result struct {
+	Ok       bool   `json:"ok"`
+	Detail   string `json:"detail,omitempty"`
+	Error    string `json:"error,omitempty"`
+	Duration string `json:"duration"`
+}
+
+// Status of the daemon as reported by /readyz
+type Status struct {
+	Ready  bool              `json:"ready"`
+	Checks map[string]Result `json:"checks"`
+}
+
+// Run executes every registered check concurrently
+// Checks not returning within oip.api.health.timeout are failed
+func Run(ctx context.Context) Status {
+	checksMutex.RLock()
+	names := make([]string, 0, len(checks))
+	for name := range checks {
+		names = append(names, name)
+	}
+	checksMutex.RUnlock()
+	sort.Strings(names)
+
+	ctx, cancel := context.WithTimeout(ctx, viper.GetDuration("oip.api.health.timeout"))
+	defer cancel()
+
+	results := make([]Result, len(names))
+	var wg sync.WaitGroup
+	for i, name := range names {
+		checksMutex.RLock()
+		check := checks[name]
+		checksMutex.RUnlock()
+
+		wg.Add(1)
+		go func(i int, check Check) {
+			defer wg.Done()
+			results[i] = run(ctx, check)
+		}(i, check)
+	}
+	wg.Wait()
+
+	status := Status{Ready: true, Checks: make(map[string]Result, len(names))}
+	for i, name := range names {
+		status.Checks[name] = results[i]
+		if !results[i].Ok {
+			status.Ready = false
+		}
+	}
+	return status
+}
+
+func run(ctx context.Context, check Check) Result {
+	type outcome struct {
+		detail string
+		err    error
+	}
+	start := time.Now()
+	done := make(chan outcome, 1)
+	go func() {
+		detail, err := check(ctx)
+		done <- outcome{detail, err}
+	}()
+
+	var o outcome
+	select {
+	case o = <-done:
+	case <-ctx.Done():
+		o.err = ctx.Err()
+	}
+
+	res := Result{Ok: o.err == nil, Detail: o.detail, Duration: time.Since(start).String()}
+	if o.err != nil {
+		res.Error = o.err.Error()
+	}
+	return res
+}
+
+// HandleLiveness reports the process is alive and able to serve requests
+func HandleLiveness(w http.ResponseWriter, r *http.Request) {
+	respond(w, http.StatusOK, map[string]interface{}{"alive": true})
+}
+
+// HandleReadiness runs every registered check, responding 503 if any failed
+func HandleReadiness(w http.ResponseWriter, r *http.Request) {
+	status := Run(r.Context())
+	code := http.StatusOK
+	if !status.Ready {
+		code = http.StatusServiceUnavailable
+		for name, res := range status.Checks {
+			if !res.Ok {
+				log.Info("readiness check failed", logger.Attrs{"check": name, "err": res.Error})
+			}
+		}
+	}
+	respond(w, code, status)
+}
+
+func respond(w http.ResponseWriter, code int, payload interface{}) {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		log.Error("unable to marshal health response", logger.Attrs{"err": err})
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_, _ = w.Write(b)
+}
@@ -0,0 +1,57 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+func TestReadiness(t *testing.T) {
+	viper.Set("oip.api.health.timeout", 50*time.Millisecond)
+	defer func() {
+		checks = make(map[string]Check)
+	}()
+
+	Register("ok", func(ctx context.Context) (string, error) {
+		return "fine", nil
+	})
+
+	rec := httptest.NewRecorder()
+	HandleReadiness(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected 200, got %d", rec.Code)
+	}
+
+	Register("broken", func(ctx context.Context) (string, error) {
+		return "", errors.New("down")
+	})
+	Register("slow", func(ctx context.Context) (string, error) {
+		time.Sleep(time.Second)
+		return "late", nil
+	})
+
+	status := Run(context.Background())
+	if status.Ready {
+		t.Error("expected not ready")
+	}
+	if res := status.Checks["ok"]; !res.Ok || res.Detail != "fine" {
+		t.Errorf("unexpected ok result %+v", res)
+	}
+	if res := status.Checks["broken"]; res.Ok || res.Error != "down" {
+		t.Errorf("unexpected broken result %+v", res)
+	}
+	if res := status.Checks["slow"]; res.Ok || res.Error != context.DeadlineExceeded.Error() {
+		t.Errorf("unexpected slow result %+v", res)
+	}
+
+	rec = httptest.NewRecorder()
+	HandleReadiness(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected 503, got %d", rec.Code)
+	}
+}
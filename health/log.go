@@ -0,0 +1,5 @@
+package health
+
+import "github.com/azer/logger"
+
+var log = logger.New("health")
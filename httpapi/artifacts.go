@@ -13,17 +13,71 @@ import (
 )
 
 func init() {
-	rootRouter.HandleFunc("/artifact/get/latest", handleLatest)
-	rootRouter.HandleFunc("/artifact/get/latest", handleLatest)
-	rootRouter.HandleFunc("/artifact/get/{id:[a-f0-9]+}", handleGet)
-	rootRouter.HandleFunc("/artifact/search", handleArtifactSearch).Queries("q", "{query}")
-	rootRouter.HandleFunc("/artifact/cardinality", handleCardinality).Queries("f", "{field:[a-zA-Z\\.]+}")
+	rootRouter.HandleFunc("/artifact/get/latest", handleLatest, RouteDoc{
+		Summary:  "Latest oip041 and oip042 artifacts",
+		Paged:    true,
+		Response: SearchResponse(nil),
+	})
+	rootRouter.HandleFunc("/artifact/get/{id:[a-f0-9]+}", handleGet, RouteDoc{
+		Summary:  "Artifacts by txid or txid prefix",
+		Response: SearchResponse(nil),
+	})
+	rootRouter.HandleFunc("/artifact/search", handleArtifactSearch, RouteDoc{
+		Summary:  "Search artifacts with an Elasticsearch query string",
+		Paged:    true,
+		Response: SearchResponse(nil),
+	}).Queries("q", "{query}")
+	rootRouter.HandleFunc("/artifact/search", artifactSearch.HandleSearch,
+		artifactSearch.SearchDoc("Search artifacts with a json search query")).Methods("POST")
+	rootRouter.HandleFunc("/artifact/facets", artifactSearch.HandleFacets,
+		artifactSearch.FacetsDoc("Facets over artifacts")).Methods("POST")
+	rootRouter.HandleFunc("/artifact/export", artifactSearch.HandleExport,
+		artifactSearch.ExportDoc("Export all artifacts matching a json search query")).Methods("GET", "POST")
+	rootRouter.HandleFunc("/artifact/cardinality", handleCardinality, RouteDoc{
+		Summary:  "Approximate count of distinct values of an oip042 artifact field",
+		Response: ObjectSchema(map[string]*Schema{"c": {Type: "number"}}),
+	}).Queries("f", "{field:[a-zA-Z\\.]+}")
 }
 
 var (
 	artifactIndices = []string{"oip041", "oip042_artifact"}
 	artifactFsc     = elastic.NewFetchSourceContext(true).
 			Include("artifact.*", "meta.block_hash", "meta.txid", "meta.originalTxid", "meta.block", "meta.time", "meta.type")
+	artifactSearch = &SearchResource{
+		Indices: artifactIndices,
+		Fields: SearchFields{
+			"artifact.floAddress":         KeywordField,
+			"artifact.publisher":          KeywordField,
+			"artifact.type":               KeywordField,
+			"artifact.subtype":            KeywordField,
+			"artifact.subType":            KeywordField,
+			"artifact.timestamp":          DateField,
+			"artifact.info.title":         TextField,
+			"artifact.info.title.keyword": KeywordField,
+			"artifact.info.description":   TextField,
+			"artifact.info.tags":          TextField,
+			"artifact.info.year":          DateField,
+			"artifact.info.nsfw":          BoolField,
+			"artifact.details.*":          DynamicField,
+			"artifact.storage.location":   KeywordField,
+			"artifact.storage.network":    KeywordField,
+			"artifact.storage.files.type": KeywordField,
+			"meta.block":                  NumericField,
+			"meta.time":                   DateField,
+			"meta.txid":                   KeywordField,
+			"meta.originalTxid":           KeywordField,
+			"meta.type":                   KeywordField,
+		},
+		Filters: []elastic.Query{
+			elastic.NewTermQuery("meta.deactivated", false),
+			elastic.NewTermQuery("meta.blacklist.blacklisted", false),
+		},
+		Sorts: []elastic.SortInfo{
+			{Field: "meta.time", Ascending: false},
+			{Field: "meta.txid", Ascending: true},
+		},
+		Fsc: artifactFsc,
+	}
 )
 
 func handleArtifactSearch(w http.ResponseWriter, r *http.Request) {
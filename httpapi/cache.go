@@ -0,0 +1,182 @@
+package httpapi
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/spf13/viper"
+	"gopkg.in/olivere/elastic.v6"
+)
+
+// CachePolicy describes how responses of a route may be cached by clients and proxies
+type CachePolicy int
+
+const (
+	// CacheShort is used for latest and search routes whose results change as new blocks arrive
+	CacheShort CachePolicy = iota
+	// CachePinned is used for content addressed by txid that never changes once stored, such as blocks
+	// and transactions, cached long once confirmed well below the tip
+	CachePinned
+	// CacheNone disables caching
+	CacheNone
+)
+
+var chainHeight int64
+
+// SetChainHeight updates the current sync height used to determine if content is safely confirmed
+func SetChainHeight(height int64) {
+	atomic.StoreInt64(&chainHeight, height)
+}
+
+// ChainHeight returns the current sync height
+func ChainHeight() int64 {
+	return atomic.LoadInt64(&chainHeight)
+}
+
+var txidRe = regexp.MustCompile(`^[a-fA-F0-9]{64}$`)
+
+// pinnedVars checks that every route variable is a complete txid
+func pinnedVars(vars map[string]string) bool {
+	for _, v := range vars {
+		if !txidRe.MatchString(v) {
+			return false
+		}
+	}
+	return true
+}
+
+type cacheState struct {
+	policy      CachePolicy
+	method      string
+	ifNoneMatch string
+	// highest block of the returned content, -1 if unknown or unconfirmed
+	block int64
+	// set once any content block has been recorded
+	blockSet bool
+}
+
+func withCacheState(r *http.Request, policy CachePolicy) *http.Request {
+	cs := &cacheState{
+		policy:      policy,
+		method:      r.Method,
+		ifNoneMatch: r.Header.Get("If-None-Match"),
+	}
+	return r.WithContext(context.WithValue(r.Context(), oipdCacheStateKey, cs))
+}
+
+func getCacheState(ctx context.Context) *cacheState {
+	cs, _ := ctx.Value(oipdCacheStateKey).(*cacheState)
+	return cs
+}
+
+// SetContentBlock records the block height of content included in the response
+// A height below 1 marks the content as unconfirmed
+func SetContentBlock(ctx context.Context, block int64) {
+	cs := getCacheState(ctx)
+	if cs == nil {
+		return
+	}
+	if block < 1 {
+		cs.block = -1
+	} else if !cs.blockSet || (cs.block != -1 && block > cs.block) {
+		cs.block = block
+	}
+	cs.blockSet = true
+}
+
+type blockSource struct {
+	Block *int64 `json:"block"`
+	Meta  struct {
+		Block *int64 `json:"block"`
+	} `json:"meta"`
+}
+
+// setContentBlockFromHits records the highest block of the search hits
+func setContentBlockFromHits(ctx context.Context, hits []*elastic.SearchHit) {
+	if getCacheState(ctx) == nil {
+		return
+	}
+	for _, hit := range hits {
+		var bs blockSource
+		if hit.Source == nil || json.Unmarshal(*hit.Source, &bs) != nil {
+			SetContentBlock(ctx, -1)
+			continue
+		}
+		switch {
+		case bs.Meta.Block != nil:
+			SetContentBlock(ctx, *bs.Meta.Block)
+		case bs.Block != nil:
+			SetContentBlock(ctx, *bs.Block)
+		default:
+			SetContentBlock(ctx, -1)
+		}
+	}
+}
+
+func (cs *cacheState) cacheControl() string {
+	switch cs.policy {
+	case CacheNone:
+		return "no-store"
+	case CachePinned:
+		confirmations := viper.GetInt64("oip.api.cache.confirmations")
+		height := ChainHeight()
+		if cs.blockSet && cs.block > 0 && height > 0 && height-cs.block >= confirmations {
+			return maxAge(viper.GetDuration("oip.api.cache.pinnedMaxAge")) + ", immutable"
+		}
+	}
+	return maxAge(viper.GetDuration("oip.api.cache.shortMaxAge"))
+}
+
+func maxAge(d time.Duration) string {
+	return "public, max-age=" + strconv.FormatInt(int64(d/time.Second), 10)
+}
+
+func etagFor(b []byte) string {
+	sum := sha1.Sum(b)
+	// weak as the body may be re-encoded by the compression handler
+	return fmt.Sprintf(`W/"%s"`, hex.EncodeToString(sum[:]))
+}
+
+func etagMatches(ifNoneMatch string, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	for _, tag := range strings.Split(ifNoneMatch, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// writeCacheHeaders sets the ETag and Cache-Control headers of a successful GET response
+// Returns true if the client copy is current and a 304 has been written in place of the body
+func writeCacheHeaders(ctx context.Context, w http.ResponseWriter, code int, b []byte) bool {
+	cs := getCacheState(ctx)
+	if cs == nil || code != http.StatusOK || (cs.method != http.MethodGet && cs.method != http.MethodHead) {
+		return false
+	}
+
+	etag := etagFor(b)
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", cs.cacheControl())
+	if h := ChainHeight(); h > 0 {
+		w.Header().Set("X-Oip-Height", strconv.FormatInt(h, 10))
+	}
+
+	if cs.policy != CacheNone && etagMatches(cs.ifNoneMatch, etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return true
+	}
+	return false
+}
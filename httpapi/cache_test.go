@@ -0,0 +1,81 @@
+package httpapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestRespondJSONCaching(t *testing.T) {
+	viper.Set("oip.api.cache.confirmations", 10)
+	viper.Set("oip.api.cache.shortMaxAge", "10s")
+	viper.Set("oip.api.cache.pinnedMaxAge", "720h")
+	SetChainHeight(1000)
+	defer SetChainHeight(0)
+
+	payload := map[string]string{"a": "b"}
+
+	respond := func(policy CachePolicy, block int64, ifNoneMatch string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/oip/test", nil)
+		if ifNoneMatch != "" {
+			r.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		r = withCacheState(r, policy)
+		if block != 0 {
+			SetContentBlock(r.Context(), block)
+		}
+		w := httptest.NewRecorder()
+		RespondJSON(r.Context(), w, http.StatusOK, payload)
+		return w
+	}
+
+	w := respond(CacheShort, 0, "")
+	etag := w.Header().Get("ETag")
+	if w.Code != http.StatusOK || etag == "" {
+		t.Fatalf("expected 200 with etag, received %d %q", w.Code, etag)
+	}
+	if cc := w.Header().Get("Cache-Control"); cc != "public, max-age=10" {
+		t.Errorf("unexpected Cache-Control %q", cc)
+	}
+
+	w = respond(CacheShort, 0, etag)
+	if w.Code != http.StatusNotModified || w.Body.Len() != 0 {
+		t.Errorf("expected empty 304, received %d", w.Code)
+	}
+
+	w = respond(CachePinned, 500, "")
+	if cc := w.Header().Get("Cache-Control"); !strings.HasSuffix(cc, "immutable") {
+		t.Errorf("expected immutable Cache-Control for confirmed content, received %q", cc)
+	}
+
+	w = respond(CachePinned, 995, "")
+	if cc := w.Header().Get("Cache-Control"); cc != "public, max-age=10" {
+		t.Errorf("expected short Cache-Control near the tip, received %q", cc)
+	}
+
+	w = respond(CachePinned, -1, "")
+	if cc := w.Header().Get("Cache-Control"); cc != "public, max-age=10" {
+		t.Errorf("expected short Cache-Control for unconfirmed content, received %q", cc)
+	}
+
+	// no cache state, ex: POST routes or handlers invoked directly
+	w = httptest.NewRecorder()
+	RespondJSON(context.Background(), w, http.StatusOK, payload)
+	if w.Header().Get("ETag") != "" {
+		t.Error("unexpected etag without cache state")
+	}
+}
+
+func TestPinnedVars(t *testing.T) {
+	txid := strings.Repeat("ab", 32)
+	if !pinnedVars(map[string]string{"id": txid}) {
+		t.Error("expected full txid to be pinned")
+	}
+	if pinnedVars(map[string]string{"id": txid[:10]}) {
+		t.Error("expected txid prefix not to be pinned")
+	}
+}
@@ -104,6 +104,7 @@ const (
 	oipdSizeKey
 	oipdFromKey
 	oipdPrettyJsonKey
+	oipdCacheStateKey
 )
 
 func GetSortInfoFromContext(ctx context.Context) []elastic.SortInfo {
@@ -52,6 +52,10 @@ func BuildCommonSearchService(ctx context.Context, indexNames []string, query el
 		Search(indices...).
 		Type("_doc").
 		Query(query)
+	if len(indices) > 1 {
+		// indices of disabled modules are never created
+		searchService = searchService.IgnoreUnavailable(true)
+	}
 
 	size := GetSizeFromContext(ctx)
 	searchService = searchService.Size(size)
@@ -0,0 +1,310 @@
+package httpapi
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/azer/logger"
+	"gopkg.in/olivere/elastic.v6"
+
+	"github.com/oipwg/oip/datastore"
+)
+
+const (
+	exportPageSize  = 1000
+	exportKeepAlive = "2m"
+	exportMaxFields = 100
+)
+
+// HandleExport is an http.HandlerFunc streaming every result of a json search request against the resource
+func (sr *SearchResource) HandleExport(w http.ResponseWriter, r *http.Request) {
+	query, err := sr.ParseSearchRequest(r)
+	if err != nil {
+		RespondJSON(r.Context(), w, http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	RespondExport(w, r, sr.Indices, query, sr.Sorts, sr.Fsc)
+}
+
+// RespondExport walks every hit of a search via scroll and streams it to the client as ndjson or csv
+// Accepts the same arguments as BuildCommonSearchService
+// The format query parameter selects ndjson (default) or csv, fields limits the exported fields
+// csv columns are flattened dot paths
+func RespondExport(w http.ResponseWriter, r *http.Request, indexNames []string, query elastic.Query, sorts []elastic.SortInfo, fsc *elastic.FetchSourceContext) {
+	ctx := r.Context()
+
+	format := strings.ToLower(r.FormValue("format"))
+	if format == "" {
+		format = "ndjson"
+	}
+	if format != "ndjson" && format != "csv" {
+		RespondJSON(ctx, w, http.StatusBadRequest, map[string]interface{}{
+			"error": "format must be ndjson or csv",
+		})
+		return
+	}
+
+	var fields []string
+	if f := r.FormValue("fields"); f != "" {
+		fields = strings.Split(f, ",")
+		if len(fields) > exportMaxFields {
+			RespondJSON(ctx, w, http.StatusBadRequest, map[string]interface{}{
+				"error": fmt.Sprintf("at most %d fields may be exported", exportMaxFields),
+			})
+			return
+		}
+		for _, field := range fields {
+			if !sourceFieldPermitted(fsc, field) {
+				RespondJSON(ctx, w, http.StatusBadRequest, map[string]interface{}{
+					"error": fmt.Sprintf("field %q may not be exported", field),
+				})
+				return
+			}
+		}
+		fsc = elastic.NewFetchSourceContext(true).Include(fields...)
+	}
+
+	var indices = make([]string, 0, len(indexNames))
+	for _, index := range indexNames {
+		indices = append(indices, datastore.Index(index))
+	}
+
+	scroll := datastore.Client().
+		Scroll(indices...).
+		Type("_doc").
+		Query(query).
+		Size(exportPageSize).
+		KeepAlive(exportKeepAlive)
+	if len(indices) > 1 {
+		// indices of disabled modules are never created
+		scroll = scroll.IgnoreUnavailable(true)
+	}
+	for _, v := range append(GetSortInfoFromContext(ctx), sorts...) {
+		scroll = scroll.SortWithInfo(v)
+	}
+	if fsc != nil {
+		scroll = scroll.FetchSourceContext(fsc)
+	}
+	defer func() {
+		// the request context may already be cancelled, clear with a fresh one
+		err := scroll.Clear(context.Background())
+		if err != nil {
+			log.Error("unable to clear export scroll", logger.Attrs{"err": err})
+		}
+	}()
+
+	// fetch the first page before writing headers so search errors may still be reported
+	res, err := scroll.Do(ctx)
+	if err != nil && err != io.EOF {
+		log.Error("export search failed", logger.Attrs{"err": err})
+		RespondESError(ctx, w, err)
+		return
+	}
+
+	var out io.Writer = w
+	w.Header().Add("Vary", "Accept-Encoding")
+	if w.Header().Get("Content-Encoding") == "" && acceptsGzip(r) {
+		// not already compressed by the outer CompressHandler
+		gw := gzip.NewWriter(w)
+		defer gw.Close()
+		out = gw
+		w.Header().Set("Content-Encoding", "gzip")
+	}
+
+	if format == "csv" {
+		w.Header().Set("Content-Type", "text/csv")
+	} else {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+	}
+	w.Header().Set("Content-Disposition", "attachment; filename=export."+format)
+	w.WriteHeader(http.StatusOK)
+
+	var cw *csv.Writer
+	if format == "csv" {
+		cw = csv.NewWriter(out)
+		if fields != nil {
+			_ = cw.Write(fields)
+		}
+	}
+
+	attr := logger.Attrs{"url": r.URL, "format": format}
+	t := log.Timer()
+	exported := 0
+	defer func() {
+		attr["exported"] = exported
+		t.End("export", attr)
+	}()
+
+	for err != io.EOF {
+		for _, hit := range res.Hits.Hits {
+			if hit.Source == nil {
+				continue
+			}
+			if cw != nil {
+				if fields == nil {
+					// no columns requested, use those of the first hit
+					fields = flattenedKeys(*hit.Source)
+					err = cw.Write(fields)
+					if err != nil {
+						attr["err"] = err
+						return
+					}
+				}
+				err = cw.Write(csvRow(*hit.Source, fields))
+			} else {
+				_, err = out.Write(append(*hit.Source, '\n'))
+			}
+			if err != nil {
+				attr["err"] = err
+				return
+			}
+			exported++
+		}
+
+		if cw != nil {
+			cw.Flush()
+		}
+		if gw, ok := out.(*gzip.Writer); ok {
+			_ = gw.Flush()
+		}
+		if f, ok := w.(http.Flusher); ok {
+			f.Flush()
+		}
+
+		if ctx.Err() != nil {
+			// client disconnected
+			attr["err"] = ctx.Err()
+			return
+		}
+
+		res, err = scroll.Do(ctx)
+		if err != nil && err != io.EOF {
+			attr["err"] = err
+			log.Error("export scroll failed", attr)
+			return
+		}
+	}
+}
+
+// acceptsGzip reports whether the Accept-Encoding header of r permits a gzip body
+func acceptsGzip(r *http.Request) bool {
+	for _, enc := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		parts := strings.Split(enc, ";")
+		name := strings.ToLower(strings.TrimSpace(parts[0]))
+		if name != "gzip" && name != "*" {
+			continue
+		}
+		q := 1.0
+		for _, p := range parts[1:] {
+			p = strings.TrimSpace(p)
+			if strings.HasPrefix(p, "q=") {
+				q, _ = strconv.ParseFloat(p[2:], 64)
+			}
+		}
+		if q > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// sourceFieldPermitted checks a requested export field against the includes of the resource
+func sourceFieldPermitted(fsc *elastic.FetchSourceContext, field string) bool {
+	if field == "" || !dynamicFieldRe.MatchString(field) {
+		return false
+	}
+	if fsc == nil || len(fsc.Includes()) == 0 {
+		return true
+	}
+	for _, inc := range fsc.Includes() {
+		if inc == field {
+			return true
+		}
+		if strings.HasSuffix(inc, "*") && strings.HasPrefix(field, strings.TrimSuffix(inc, "*")) {
+			return true
+		}
+		if strings.HasPrefix(field, inc+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// flattenSource converts a json document into a map of dot separated paths to string values
+// Arrays are kept as json
+func flattenSource(src []byte) map[string]string {
+	var doc map[string]interface{}
+	res := make(map[string]string)
+	if json.Unmarshal(src, &doc) != nil {
+		return res
+	}
+	flatten("", doc, res)
+	return res
+}
+
+func flatten(prefix string, v interface{}, res map[string]string) {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, sub := range val {
+			if prefix != "" {
+				k = prefix + "." + k
+			}
+			flatten(k, sub, res)
+		}
+	case []interface{}:
+		b, _ := json.Marshal(val)
+		res[prefix] = string(b)
+	case string:
+		res[prefix] = val
+	case float64:
+		res[prefix] = strconv.FormatFloat(val, 'f', -1, 64)
+	case bool:
+		res[prefix] = strconv.FormatBool(val)
+	case nil:
+		res[prefix] = ""
+	}
+}
+
+func flattenedKeys(src []byte) []string {
+	flat := flattenSource(src)
+	keys := make([]string, 0, len(flat))
+	for k := range flat {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func csvRow(src []byte, fields []string) []string {
+	flat := flattenSource(src)
+	row := make([]string, len(fields))
+	for i, f := range fields {
+		if v, ok := flat[f]; ok {
+			row[i] = v
+			continue
+		}
+		// a requested field may be an object, gather its children as json
+		var children = make(map[string]string)
+		for k, v := range flat {
+			if strings.HasPrefix(k, f+".") {
+				children[strings.TrimPrefix(k, f+".")] = v
+			}
+		}
+		if len(children) > 0 {
+			b, _ := json.Marshal(children)
+			row[i] = string(b)
+		}
+	}
+	return row
+}
@@ -0,0 +1,66 @@
+package httpapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"gopkg.in/olivere/elastic.v6"
+)
+
+func TestCsvRow(t *testing.T) {
+	src := []byte(`{"meta":{"txid":"abc","block":12,"latest":true,"signature":null},"tags":["a","b"]}`)
+
+	keys := flattenedKeys(src)
+	expectedKeys := []string{"meta.block", "meta.latest", "meta.signature", "meta.txid", "tags"}
+	if !reflect.DeepEqual(keys, expectedKeys) {
+		t.Errorf("expected %v, received %v", expectedKeys, keys)
+	}
+
+	row := csvRow(src, []string{"meta.txid", "meta.block", "tags", "missing", "meta"})
+	expected := []string{"abc", "12", `["a","b"]`, "", `{"block":"12","latest":"true","signature":"","txid":"abc"}`}
+	if !reflect.DeepEqual(row, expected) {
+		t.Errorf("expected %v, received %v", expected, row)
+	}
+}
+
+func TestSourceFieldPermitted(t *testing.T) {
+	fsc := elastic.NewFetchSourceContext(true).Include("meta", "record.details.*")
+	cases := map[string]bool{
+		"meta":                      true,
+		"meta.txid":                 true,
+		"record.details.tmpl_1.foo": true,
+		"record.raw":                false,
+		"metadata":                  false,
+		"meta txid":                 false,
+		"":                          false,
+	}
+	for field, expected := range cases {
+		if sourceFieldPermitted(fsc, field) != expected {
+			t.Errorf("field %q expected %v", field, expected)
+		}
+	}
+}
+
+func TestAcceptsGzip(t *testing.T) {
+	cases := map[string]bool{
+		"":                       false,
+		"identity":               false,
+		"gzip":                   true,
+		"deflate, GZIP":          true,
+		"gzip;q=0":               false,
+		"gzip; q=0.5, br":        true,
+		"*":                      true,
+		"br;q=1.0, gzip;q=0.000": false,
+	}
+	for header, expected := range cases {
+		r := httptest.NewRequest(http.MethodGet, "/oip/export", nil)
+		if header != "" {
+			r.Header.Set("Accept-Encoding", header)
+		}
+		if acceptsGzip(r) != expected {
+			t.Errorf("Accept-Encoding %q expected %v", header, expected)
+		}
+	}
+}
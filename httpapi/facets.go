@@ -0,0 +1,342 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/azer/logger"
+	"github.com/pkg/errors"
+	"gopkg.in/olivere/elastic.v6"
+
+	"github.com/oipwg/oip/datastore"
+)
+
+const (
+	maxFacetDepth  = 3
+	maxFacetCount  = 10
+	maxFacetSize   = 1000
+	maxFacetRanges = 50
+)
+
+var validIntervals = map[string]bool{
+	"minute":  true,
+	"hour":    true,
+	"day":     true,
+	"week":    true,
+	"month":   true,
+	"quarter": true,
+	"year":    true,
+}
+
+// FacetRequest is the body accepted by POST facet endpoints
+type FacetRequest struct {
+	Query  json.RawMessage       `json:"query"`
+	Facets map[string]*FacetSpec `json:"facets"`
+}
+
+// FacetSpec describes a single facet, exactly one of Terms, DateHistogram, Range or Cardinality must be set
+// Bucketed facets may contain nested Facets
+type FacetSpec struct {
+	Terms         *termsFacet           `json:"terms,omitempty"`
+	DateHistogram *dateHistogramFacet   `json:"date_histogram,omitempty"`
+	Range         *rangeFacet           `json:"range,omitempty"`
+	Cardinality   *cardinalityFacet     `json:"cardinality,omitempty"`
+	Facets        map[string]*FacetSpec `json:"facets,omitempty"`
+}
+
+type termsFacet struct {
+	Field string `json:"field"`
+	Size  int    `json:"size"`
+}
+
+type dateHistogramFacet struct {
+	Field    string `json:"field"`
+	Interval string `json:"interval"`
+	Format   string `json:"format"`
+}
+
+type rangeFacet struct {
+	Field  string `json:"field"`
+	Ranges []struct {
+		Key  string   `json:"key"`
+		From *float64 `json:"from"`
+		To   *float64 `json:"to"`
+	} `json:"ranges"`
+}
+
+type cardinalityFacet struct {
+	Field string `json:"field"`
+}
+
+// FacetBucket is a single bucket of a bucketed facet
+type FacetBucket struct {
+	Key    interface{}             `json:"key"`
+	Count  int64                   `json:"count"`
+	Facets map[string]*FacetResult `json:"facets,omitempty"`
+}
+
+// FacetResult holds either the buckets of a bucketed facet or the value of a metric facet
+type FacetResult struct {
+	Buckets []FacetBucket `json:"buckets,omitempty"`
+	Value   *float64      `json:"value,omitempty"`
+}
+
+type facetBuilder struct {
+	fields SearchFields
+	count  int
+}
+
+// BuildFacets validates the requested facets against the allow-list and creates the matching aggregations
+func BuildFacets(facets map[string]*FacetSpec, fields SearchFields) (map[string]elastic.Aggregation, error) {
+	if len(facets) == 0 {
+		return nil, errors.New("at least one facet is required")
+	}
+	fb := &facetBuilder{fields: fields}
+	return fb.build(facets, 0)
+}
+
+func (fb *facetBuilder) build(facets map[string]*FacetSpec, depth int) (map[string]elastic.Aggregation, error) {
+	if depth >= maxFacetDepth {
+		return nil, errors.New("facets nested too deeply")
+	}
+	aggs := make(map[string]elastic.Aggregation, len(facets))
+	for name, spec := range facets {
+		fb.count++
+		if fb.count > maxFacetCount {
+			return nil, fmt.Errorf("at most %d facets may be requested", maxFacetCount)
+		}
+		if spec == nil {
+			return nil, fmt.Errorf("facet %q is empty", name)
+		}
+		agg, err := fb.buildOne(name, spec, depth)
+		if err != nil {
+			return nil, err
+		}
+		aggs[name] = agg
+	}
+	return aggs, nil
+}
+
+func (fb *facetBuilder) buildOne(name string, spec *FacetSpec, depth int) (elastic.Aggregation, error) {
+	set := 0
+	for _, s := range []bool{spec.Terms != nil, spec.DateHistogram != nil, spec.Range != nil, spec.Cardinality != nil} {
+		if s {
+			set++
+		}
+	}
+	if set != 1 {
+		return nil, fmt.Errorf("facet %q must specify exactly one of terms, date_histogram, range or cardinality", name)
+	}
+
+	var subAggs map[string]elastic.Aggregation
+	if len(spec.Facets) > 0 {
+		if spec.Cardinality != nil {
+			return nil, fmt.Errorf("facet %q: cardinality may not contain nested facets", name)
+		}
+		var err error
+		subAggs, err = fb.build(spec.Facets, depth+1)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case spec.Terms != nil:
+		if err := fb.checkField(spec.Terms.Field, KeywordField, NumericField, DateField, BoolField); err != nil {
+			return nil, err
+		}
+		size := spec.Terms.Size
+		if size <= 0 {
+			size = 10
+		}
+		if size > maxFacetSize {
+			size = maxFacetSize
+		}
+		agg := elastic.NewTermsAggregation().Field(spec.Terms.Field).Size(size)
+		for n, sub := range subAggs {
+			agg.SubAggregation(n, sub)
+		}
+		return agg, nil
+	case spec.DateHistogram != nil:
+		if err := fb.checkField(spec.DateHistogram.Field, DateField); err != nil {
+			return nil, err
+		}
+		if !validIntervals[spec.DateHistogram.Interval] {
+			return nil, fmt.Errorf("facet %q: unsupported interval %q", name, spec.DateHistogram.Interval)
+		}
+		agg := elastic.NewDateHistogramAggregation().
+			Field(spec.DateHistogram.Field).
+			Interval(spec.DateHistogram.Interval).
+			MinDocCount(1)
+		if spec.DateHistogram.Format != "" {
+			agg.Format(spec.DateHistogram.Format)
+		}
+		for n, sub := range subAggs {
+			agg.SubAggregation(n, sub)
+		}
+		return agg, nil
+	case spec.Range != nil:
+		if err := fb.checkField(spec.Range.Field, NumericField, DateField); err != nil {
+			return nil, err
+		}
+		if len(spec.Range.Ranges) == 0 || len(spec.Range.Ranges) > maxFacetRanges {
+			return nil, fmt.Errorf("facet %q: between 1 and %d ranges required", name, maxFacetRanges)
+		}
+		agg := elastic.NewRangeAggregation().Field(spec.Range.Field)
+		for _, r := range spec.Range.Ranges {
+			var from, to interface{}
+			if r.From != nil {
+				from = *r.From
+			}
+			if r.To != nil {
+				to = *r.To
+			}
+			if r.Key != "" {
+				agg.AddRangeWithKey(r.Key, from, to)
+			} else {
+				agg.AddRange(from, to)
+			}
+		}
+		for n, sub := range subAggs {
+			agg.SubAggregation(n, sub)
+		}
+		return agg, nil
+	default:
+		if err := fb.checkField(spec.Cardinality.Field, KeywordField, NumericField, DateField, BoolField); err != nil {
+			return nil, err
+		}
+		return elastic.NewCardinalityAggregation().Field(spec.Cardinality.Field), nil
+	}
+}
+
+func (fb *facetBuilder) checkField(field string, allowed ...FieldType) error {
+	ft, ok := fb.fields.Lookup(field)
+	if !ok {
+		return fmt.Errorf("field %q is not available for facets", field)
+	}
+	if ft == DynamicField {
+		return nil
+	}
+	for _, a := range allowed {
+		if ft == a {
+			return nil
+		}
+	}
+	return fmt.Errorf("facet type not supported on field %q", field)
+}
+
+// ExtractFacets reads the aggregation results matching the requested facets
+func ExtractFacets(aggs elastic.Aggregations, facets map[string]*FacetSpec) map[string]*FacetResult {
+	res := make(map[string]*FacetResult, len(facets))
+	for name, spec := range facets {
+		fr := &FacetResult{}
+		switch {
+		case spec.Terms != nil:
+			if terms, ok := aggs.Terms(name); ok {
+				for _, b := range terms.Buckets {
+					fr.Buckets = append(fr.Buckets, FacetBucket{
+						Key:    b.Key,
+						Count:  b.DocCount,
+						Facets: extractNested(b.Aggregations, spec.Facets),
+					})
+				}
+			}
+		case spec.DateHistogram != nil:
+			if hist, ok := aggs.DateHistogram(name); ok {
+				for _, b := range hist.Buckets {
+					var key interface{} = b.Key
+					if b.KeyAsString != nil {
+						key = *b.KeyAsString
+					}
+					fr.Buckets = append(fr.Buckets, FacetBucket{
+						Key:    key,
+						Count:  b.DocCount,
+						Facets: extractNested(b.Aggregations, spec.Facets),
+					})
+				}
+			}
+		case spec.Range != nil:
+			if rng, ok := aggs.Range(name); ok {
+				for _, b := range rng.Buckets {
+					fr.Buckets = append(fr.Buckets, FacetBucket{
+						Key:    b.Key,
+						Count:  b.DocCount,
+						Facets: extractNested(b.Aggregations, spec.Facets),
+					})
+				}
+			}
+		case spec.Cardinality != nil:
+			if card, ok := aggs.Cardinality(name); ok {
+				fr.Value = card.Value
+			}
+		}
+		res[name] = fr
+	}
+	return res
+}
+
+func extractNested(aggs elastic.Aggregations, facets map[string]*FacetSpec) map[string]*FacetResult {
+	if len(facets) == 0 {
+		return nil
+	}
+	return ExtractFacets(aggs, facets)
+}
+
+// HandleFacets is an http.HandlerFunc computing the requested facets over the resource, optionally filtered by a json search query
+func (sr *SearchResource) HandleFacets(w http.ResponseWriter, r *http.Request) {
+	var req FacetRequest
+	err := decodeJsonBody(r, &req)
+	if err != nil {
+		RespondJSON(r.Context(), w, http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	uq, err := ParseSearchQuery(req.Query, sr.Fields)
+	if err != nil {
+		RespondJSON(r.Context(), w, http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	aggs, err := BuildFacets(req.Facets, sr.Fields)
+	if err != nil {
+		RespondJSON(r.Context(), w, http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var indices = make([]string, 0, len(sr.Indices))
+	for _, index := range sr.Indices {
+		indices = append(indices, datastore.Index(index))
+	}
+
+	searchService := datastore.Client().
+		Search(indices...).
+		Type("_doc").
+		Query(sr.BuildQuery(uq)).
+		Size(0)
+	if len(indices) > 1 {
+		// indices of disabled modules are never created
+		searchService = searchService.IgnoreUnavailable(true)
+	}
+	for name, agg := range aggs {
+		searchService = searchService.Aggregation(name, agg)
+	}
+
+	res, err := searchService.Do(r.Context())
+	if err != nil {
+		log.Error("facet search failed", logger.Attrs{"err": err})
+		RespondESError(r.Context(), w, err)
+		return
+	}
+
+	RespondJSON(r.Context(), w, http.StatusOK, map[string]interface{}{
+		"total":  res.Hits.TotalHits,
+		"facets": ExtractFacets(res.Aggregations, req.Facets),
+	})
+}
@@ -0,0 +1,69 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBuildFacets(t *testing.T) {
+	cases := []struct {
+		name     string
+		facets   string
+		expected string
+		err      bool
+	}{
+		{
+			name:     "terms",
+			facets:   `{"top":{"terms":{"field":"meta.txid","size":5}}}`,
+			expected: `{"terms":{"field":"meta.txid","size":5}}`,
+		},
+		{
+			name:     "nested date histogram",
+			facets:   `{"top":{"terms":{"field":"meta.txid"},"facets":{"monthly":{"date_histogram":{"field":"meta.time","interval":"month"}}}}}`,
+			expected: `{"aggregations":{"monthly":{"date_histogram":{"field":"meta.time","interval":"month","min_doc_count":1}}},"terms":{"field":"meta.txid","size":10}}`,
+		},
+		{
+			name:     "cardinality",
+			facets:   `{"top":{"cardinality":{"field":"meta.block"}}}`,
+			expected: `{"cardinality":{"field":"meta.block"}}`,
+		},
+		{name: "text field", facets: `{"top":{"terms":{"field":"info.title"}}}`, err: true},
+		{name: "unknown field", facets: `{"top":{"terms":{"field":"secret"}}}`, err: true},
+		{name: "bad interval", facets: `{"top":{"date_histogram":{"field":"meta.time","interval":"fortnight"}}}`, err: true},
+		{name: "two types", facets: `{"top":{"terms":{"field":"meta.txid"},"cardinality":{"field":"meta.txid"}}}`, err: true},
+		{name: "nested cardinality", facets: `{"top":{"cardinality":{"field":"meta.txid"},"facets":{"a":{"terms":{"field":"meta.txid"}}}}}`, err: true},
+		{name: "empty range", facets: `{"top":{"range":{"field":"meta.block","ranges":[]}}}`, err: true},
+		{name: "none", facets: `{}`, err: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var specs map[string]*FacetSpec
+			err := json.Unmarshal([]byte(c.facets), &specs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			aggs, err := BuildFacets(specs, testSearchFields)
+			if c.err {
+				if err == nil {
+					t.Fatalf("expected error for %s", c.facets)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			src, err := aggs["top"].Source()
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := json.Marshal(src)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != c.expected {
+				t.Errorf("expected %s, received %s", c.expected, string(b))
+			}
+		})
+	}
+}
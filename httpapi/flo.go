@@ -11,18 +11,82 @@ import (
 )
 
 func init() {
-	rootRouter.HandleFunc("/floData/get/{id:[a-f0-9]+}", handleGetFloData)
-	rootRouter.HandleFunc("/floData/latest", handleFloDataLatest)
-	rootRouter.HandleFunc("/floData/search", handleFloDataSearch).Queries("q", "{query}")
-
-	rootRouter.HandleFunc("/flo/tx/latest", handleFloTxLatest)
-	rootRouter.HandleFunc("/flo/tx/get/{id:[a-f0-9]+}", handleGetFloTx)
-	rootRouter.HandleFunc("/flo/tx/search", handleFloTxSearch).Queries("q", "{query}")
+	rootRouter.HandleFunc("/floData/get/{id:[a-f0-9]+}", handleGetFloData, RouteDoc{
+		Summary:  "floData of a transaction by txid",
+		Response: SearchResponse(nil),
+		Cache:    CachePinned,
+	})
+	rootRouter.HandleFunc("/floData/latest", handleFloDataLatest, RouteDoc{
+		Summary:  "Latest transactions containing floData",
+		Paged:    true,
+		Response: SearchResponse(nil),
+	})
+	rootRouter.HandleFunc("/floData/search", handleFloDataSearch, RouteDoc{
+		Summary:  "Search floData with an Elasticsearch query string",
+		Paged:    true,
+		Response: SearchResponse(nil),
+	}).Queries("q", "{query}")
+	rootRouter.HandleFunc("/floData/search", floDataSearch.HandleSearch,
+		floDataSearch.SearchDoc("Search floData with a json search query")).Methods("POST")
+	rootRouter.HandleFunc("/floData/export", floDataSearch.HandleExport,
+		floDataSearch.ExportDoc("Export all floData matching a json search query")).Methods("GET", "POST")
+
+	rootRouter.HandleFunc("/flo/tx/latest", handleFloTxLatest, RouteDoc{
+		Summary:  "Latest flo transactions",
+		Paged:    true,
+		Response: SearchResponse(nil),
+	})
+	rootRouter.HandleFunc("/flo/tx/get/{id:[a-f0-9]+}", handleGetFloTx, RouteDoc{
+		Summary:  "Flo transaction by txid",
+		Response: SearchResponse(nil),
+		Cache:    CachePinned,
+	})
+	rootRouter.HandleFunc("/flo/tx/search", handleFloTxSearch, RouteDoc{
+		Summary:  "Search flo transactions with an Elasticsearch query string",
+		Paged:    true,
+		Response: SearchResponse(nil),
+	}).Queries("q", "{query}")
+	rootRouter.HandleFunc("/flo/tx/search", floTxSearch.HandleSearch,
+		floTxSearch.SearchDoc("Search flo transactions with a json search query")).Methods("POST")
+	rootRouter.HandleFunc("/flo/tx/facets", floTxSearch.HandleFacets,
+		floTxSearch.FacetsDoc("Facets over flo transactions")).Methods("POST")
+	rootRouter.HandleFunc("/flo/tx/export", floTxSearch.HandleExport,
+		floTxSearch.ExportDoc("Export all flo transactions matching a json search query")).Methods("GET", "POST")
 }
 
 var (
 	floDataFsc = elastic.NewFetchSourceContext(true).
-		Include("tx.floData", "tx.txid", "tx.time", "tx.blockhash", "tx.size", "is_coinbase")
+			Include("tx.floData", "tx.txid", "tx.time", "tx.blockhash", "tx.size", "is_coinbase")
+	txSearchFields = SearchFields{
+		"block":              NumericField,
+		"block_hash":         KeywordField,
+		"confirmed":          BoolField,
+		"is_coinbase":        BoolField,
+		"fee":                NumericField,
+		"fee_sat":            NumericField,
+		"tx.txid":            KeywordField,
+		"tx.blockhash":       KeywordField,
+		"tx.time":            DateField,
+		"tx.size":            NumericField,
+		"tx.floData":         TextField,
+		"tx.floData.keyword": KeywordField,
+	}
+	txSorts = []elastic.SortInfo{
+		{Ascending: false, Field: "tx.time"},
+		{Field: "tx.txid", Ascending: true},
+	}
+	floDataSearch = &SearchResource{
+		Indices: []string{"transactions"},
+		Fields:  txSearchFields,
+		Filters: []elastic.Query{elastic.NewExistsQuery("tx.floData")},
+		Sorts:   txSorts,
+		Fsc:     floDataFsc,
+	}
+	floTxSearch = &SearchResource{
+		Indices: []string{"transactions"},
+		Fields:  txSearchFields,
+		Sorts:   txSorts,
+	}
 )
 
 func handleGetFloData(w http.ResponseWriter, r *http.Request) {
@@ -6,6 +6,8 @@ import (
 
 	"github.com/azer/logger"
 
+	"github.com/oipwg/oip/config"
+	"github.com/oipwg/oip/dispatch"
 	"github.com/oipwg/oip/version"
 )
 
@@ -20,6 +22,13 @@ func handleVersion(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+func handleRoutes(w http.ResponseWriter, r *http.Request) {
+	RespondJSON(r.Context(), w, http.StatusOK, map[string]interface{}{
+		"testnet": config.IsTestnet(),
+		"routes":  dispatch.Routes(),
+	})
+}
+
 func handle404(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "text/plain")
 	w.WriteHeader(http.StatusNotFound)
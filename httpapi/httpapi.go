@@ -10,12 +10,17 @@ import (
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
 	json "github.com/json-iterator/go"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/cors"
 	"github.com/spf13/viper"
 	"gopkg.in/olivere/elastic.v6"
+
+	"github.com/oipwg/oip/dispatch"
+	"github.com/oipwg/oip/health"
 )
 
-var rootRouter = mux.NewRouter().PathPrefix("/oip").Subrouter()
+var rootMux = mux.NewRouter().PathPrefix("/oip").Subrouter()
+var rootRouter = &Router{router: rootMux}
 var daemonRoutes = NewSubRoute("/daemon")
 
 var (
@@ -23,26 +28,41 @@ var (
 )
 
 func init() {
-	rootRouter.Use(logRequests)
-	rootRouter.Use(commonParameterParser)
-	rootRouter.NotFoundHandler = http.HandlerFunc(handle404)
+	rootMux.Use(logRequests)
+	rootMux.Use(observeRequests)
+	rootMux.Use(commonParameterParser)
+	rootMux.NotFoundHandler = http.HandlerFunc(handle404)
 
-	daemonRoutes.HandleFunc("/version", handleVersion)
+	daemonRoutes.HandleFunc("/version", handleVersion, RouteDoc{
+		Summary:  "Build and uptime information of the running daemon",
+		Cache:    CacheNone,
+		Response: ObjectSchema(nil).WithAdditional(&Schema{Type: "string"}),
+	})
+	daemonRoutes.HandleFunc("/routes", handleRoutes, RouteDoc{
+		Summary:     "floData dispatch routes in the order they are tested",
+		Description: "Each route lists its matcher, priority, per network height window and matches since startup.",
+		Cache:       CacheNone,
+		Response: ObjectSchema(map[string]*Schema{
+			"testnet": {Type: "boolean"},
+			"routes":  ArraySchema(SchemaOf(dispatch.RouteInfo{})),
+		}),
+	})
 }
 
 func Serve() {
 	apiStartup = time.Now()
 	listen := viper.GetString("oip.api.listen")
-	err := http.ListenAndServe(listen, handlers.CompressHandler(cors.Default().Handler(rootRouter)))
+	root := http.NewServeMux()
+	root.Handle("/metrics", promhttp.Handler())
+	root.HandleFunc("/healthz", health.HandleLiveness)
+	root.HandleFunc("/readyz", health.HandleReadiness)
+	root.Handle("/", handlers.CompressHandler(cors.Default().Handler(rootMux)))
+	err := http.ListenAndServe(listen, root)
 	if err != nil {
 		log.Error("Error serving http api", logger.Attrs{"err": err, "listen": listen})
 	}
 }
 
-func NewSubRoute(prefix string) *mux.Router {
-	return rootRouter.PathPrefix(prefix).Subrouter()
-}
-
 func RespondJSON(ctx context.Context, w http.ResponseWriter, code int, payload interface{}) {
 	pretty := GetPrettyJsonFromContext(ctx)
 	var b []byte
@@ -64,6 +84,9 @@ func RespondJSON(ctx context.Context, w http.ResponseWriter, code int, payload i
 	}
 
 	w.Header().Set("Content-Type", "application/json")
+	if writeCacheHeaders(ctx, w, code, b) {
+		return
+	}
 	w.WriteHeader(code)
 	n, err := w.Write(b)
 	if err != nil {
@@ -92,6 +115,7 @@ func RespondSearch(ctx context.Context, w http.ResponseWriter, searchService *el
 		RespondESError(ctx, w, err)
 		return
 	}
+	setContentBlockFromHits(ctx, results.Hits.Hits)
 	sources, nextAfter := ExtractSources(results, GetPrettyJsonFromContext(ctx))
 	RespondJSON(ctx, w, http.StatusOK, map[string]interface{}{
 		"count":   len(results.Hits.Hits),
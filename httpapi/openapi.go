@@ -0,0 +1,460 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/azer/logger"
+	"github.com/gobuffalo/packr/v2"
+	"github.com/gorilla/mux"
+	"github.com/spf13/viper"
+
+	"github.com/oipwg/oip/version"
+)
+
+var explorerBox = packr.New("explorer", "./explorer")
+
+func init() {
+	rootRouter.HandleFunc("/openapi.json", handleOpenApi, RouteDoc{
+		Summary:  "OpenAPI 3 document describing every registered route",
+		Cache:    CacheNone,
+		Response: AnyObject,
+	})
+	rootRouter.HandleFunc("/explorer", handleExplorer, RouteDoc{
+		Summary:     "Interactive explorer for the OpenAPI document",
+		ContentType: "text/html",
+	})
+	rootRouter.HandleFunc("/explorer/{file:swagger-ui(?:-bundle\\.js|\\.css)}", handleExplorerAsset, RouteDoc{
+		Summary:     "Bundled swagger-ui 3.24.3 assets used by the explorer",
+		ContentType: "application/javascript",
+	})
+}
+
+// Schema is the subset of an OpenAPI schema object used to describe requests and responses
+type Schema struct {
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+}
+
+// WithAdditional sets the schema of any properties not listed
+func (s *Schema) WithAdditional(additional *Schema) *Schema {
+	s.AdditionalProperties = additional
+	return s
+}
+
+var (
+	// AnyObject describes an arbitrary json object
+	AnyObject = &Schema{Type: "object"}
+	// ErrorResponse describes the body returned alongside 4xx and 5xx responses
+	ErrorResponse = ObjectSchema(map[string]*Schema{"error": {Type: "string"}})
+)
+
+// ObjectSchema creates a schema of an object with the provided properties
+func ObjectSchema(properties map[string]*Schema) *Schema {
+	return &Schema{Type: "object", Properties: properties}
+}
+
+// ArraySchema creates a schema of an array of items
+func ArraySchema(items *Schema) *Schema {
+	return &Schema{Type: "array", Items: items}
+}
+
+// SearchResponse describes the body written by RespondSearch with results of the provided schema
+func SearchResponse(result *Schema) *Schema {
+	if result == nil {
+		result = AnyObject
+	}
+	return ObjectSchema(map[string]*Schema{
+		"count":   {Type: "integer", Description: "number of results returned"},
+		"total":   {Type: "integer", Description: "total number of matching results"},
+		"results": ArraySchema(result),
+		"next":    {Type: "string", Description: "value to provide as after to retrieve the next page"},
+	})
+}
+
+var (
+	timeType = reflect.TypeOf(time.Time{})
+	rawType  = reflect.TypeOf(json.RawMessage{})
+)
+
+// SchemaOf derives a schema from the type of v using its json struct tags
+func SchemaOf(v interface{}) *Schema {
+	return schemaOfType(reflect.TypeOf(v), make(map[reflect.Type]bool))
+}
+
+func schemaOfType(t reflect.Type, seen map[reflect.Type]bool) *Schema {
+	if t == nil {
+		return AnyObject
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t {
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case rawType:
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return ArraySchema(schemaOfType(t.Elem(), seen))
+	case reflect.Map:
+		return ObjectSchema(nil).WithAdditional(schemaOfType(t.Elem(), seen))
+	case reflect.Struct:
+		if seen[t] {
+			// recursive type, avoid infinite expansion
+			return AnyObject
+		}
+		seen[t] = true
+		defer delete(seen, t)
+
+		s := ObjectSchema(make(map[string]*Schema))
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.PkgPath != "" {
+				continue
+			}
+			name := f.Name
+			if tag, ok := f.Tag.Lookup("json"); ok {
+				tagName := strings.Split(tag, ",")[0]
+				if tagName == "-" {
+					continue
+				}
+				if tagName != "" {
+					name = tagName
+				}
+			}
+			s.Properties[name] = schemaOfType(f.Type, seen)
+		}
+		return s
+	default:
+		return &Schema{}
+	}
+}
+
+type openApiParam struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required"`
+	Schema      *Schema `json:"schema,omitempty"`
+}
+
+type openApiMedia struct {
+	Schema *Schema `json:"schema,omitempty"`
+}
+
+type openApiBody struct {
+	Required bool                    `json:"required"`
+	Content  map[string]openApiMedia `json:"content"`
+}
+
+type openApiResponse struct {
+	Description string                  `json:"description"`
+	Content     map[string]openApiMedia `json:"content,omitempty"`
+}
+
+type openApiOperation struct {
+	Summary     string                     `json:"summary,omitempty"`
+	Description string                     `json:"description,omitempty"`
+	Tags        []string                   `json:"tags,omitempty"`
+	Parameters  []*openApiParam            `json:"parameters,omitempty"`
+	RequestBody *openApiBody               `json:"requestBody,omitempty"`
+	Responses   map[string]openApiResponse `json:"responses"`
+}
+
+type openApiDocument struct {
+	OpenApi string                                  `json:"openapi"`
+	Info    map[string]string                       `json:"info"`
+	Paths   map[string]map[string]*openApiOperation `json:"paths"`
+}
+
+var pagedParams = []Param{
+	{Name: "after", In: "query", Description: "next value of a previous response, used for deep pagination"},
+	{Name: "limit", In: "query", Description: "results per page, 1-1000; default 10", Schema: &Schema{Type: "integer"}},
+	{Name: "page", In: "query", Description: "page number, limited to the first 10,000 results", Schema: &Schema{Type: "integer"}},
+	{Name: "sort", In: "query", Description: "fieldname:[a|d]$fieldname:[a|d]"},
+}
+
+var prettyParam = Param{Name: "pretty", In: "query", Description: "indent json responses", Schema: &Schema{Type: "boolean"}}
+
+// buildOpenApi walks all routes registered on the router and generates an OpenAPI 3 document
+func buildOpenApi(router *mux.Router) (*openApiDocument, error) {
+	doc := &openApiDocument{
+		OpenApi: "3.0.2",
+		Info: map[string]string{
+			"title":   "oipd",
+			"version": version.GitCommitHash,
+		},
+		Paths: make(map[string]map[string]*openApiOperation),
+	}
+
+	err := router.Walk(func(route *mux.Route, router *mux.Router, ancestors []*mux.Route) error {
+		if route.GetHandler() == nil {
+			// sub-router prefix
+			return nil
+		}
+		tmpl, err := route.GetPathTemplate()
+		if err != nil {
+			return nil
+		}
+		path, pathParams := convertPathTemplate(tmpl)
+
+		entry, _ := getRouteEntry(route)
+		rd := entry.doc
+
+		params := pathParams
+		queries, _ := route.GetQueriesTemplates()
+		for _, q := range queries {
+			params = append(params, convertQueryTemplate(q))
+		}
+		if rd.Paged {
+			params = append(params, pagedParams...)
+		}
+		params = append(params, rd.Params...)
+		params = append(params, prettyParam)
+
+		methods, err := route.GetMethods()
+		if err != nil || len(methods) == 0 {
+			methods = []string{"GET"}
+		}
+
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = make(map[string]*openApiOperation)
+		}
+		for _, m := range methods {
+			m = strings.ToLower(m)
+			op := buildOperation(rd, entry.tag, path, params, m)
+			if existing, ok := doc.Paths[path][m]; ok {
+				mergeOperations(existing, op)
+				continue
+			}
+			doc.Paths[path][m] = op
+		}
+		return nil
+	})
+
+	return doc, err
+}
+
+func buildOperation(rd RouteDoc, tag string, path string, params []Param, method string) *openApiOperation {
+	if tag == "" {
+		tag = strings.SplitN(strings.TrimPrefix(strings.TrimPrefix(path, "/oip"), "/"), "/", 2)[0]
+	}
+	op := &openApiOperation{
+		Summary:     rd.Summary,
+		Description: rd.Description,
+		Tags:        []string{tag},
+		Responses:   make(map[string]openApiResponse),
+	}
+
+	for _, p := range params {
+		schema := p.Schema
+		if schema == nil {
+			schema = &Schema{Type: "string"}
+		}
+		in := p.In
+		if in == "" {
+			in = "query"
+		}
+		op.Parameters = append(op.Parameters, &openApiParam{
+			Name:        p.Name,
+			In:          in,
+			Description: p.Description,
+			Required:    p.Required || in == "path",
+			Schema:      schema,
+		})
+	}
+
+	if rd.Body != nil && method != "get" {
+		op.RequestBody = &openApiBody{
+			Content: map[string]openApiMedia{"application/json": {Schema: rd.Body}},
+		}
+	}
+
+	contentType := rd.ContentType
+	if contentType == "" {
+		contentType = "application/json"
+	}
+	op.Responses["200"] = openApiResponse{
+		Description: "success",
+		Content:     map[string]openApiMedia{contentType: {Schema: rd.Response}},
+	}
+	if rd.Body != nil || len(params) > 1 {
+		op.Responses["400"] = openApiResponse{
+			Description: "invalid request",
+			Content:     map[string]openApiMedia{"application/json": {Schema: ErrorResponse}},
+		}
+	}
+	return op
+}
+
+// mergeOperations combines two routes sharing a path and method, ex: with and without a query matcher
+// Parameters not present on both become optional
+func mergeOperations(existing *openApiOperation, op *openApiOperation) {
+	if existing.Summary == "" {
+		existing.Summary = op.Summary
+	}
+	if existing.RequestBody == nil {
+		existing.RequestBody = op.RequestBody
+	}
+	inOp := make(map[string]bool)
+	for _, p := range op.Parameters {
+		inOp[p.In+p.Name] = true
+	}
+	inExisting := make(map[string]bool)
+	for _, p := range existing.Parameters {
+		inExisting[p.In+p.Name] = true
+		if !inOp[p.In+p.Name] {
+			p.Required = false
+		}
+	}
+	for _, p := range op.Parameters {
+		if !inExisting[p.In+p.Name] {
+			p.Required = false
+			existing.Parameters = append(existing.Parameters, p)
+		}
+	}
+	for code, res := range op.Responses {
+		if _, ok := existing.Responses[code]; !ok {
+			existing.Responses[code] = res
+		}
+	}
+}
+
+// convertPathTemplate converts a mux path template into an OpenAPI path and its parameters
+// ex: /get/{id:[a-f0-9]+} -> /get/{id}
+func convertPathTemplate(tmpl string) (string, []Param) {
+	var path strings.Builder
+	var params []Param
+	for i := 0; i < len(tmpl); i++ {
+		if tmpl[i] != '{' {
+			path.WriteByte(tmpl[i])
+			continue
+		}
+		end := matchingBrace(tmpl, i)
+		if end == -1 {
+			path.WriteString(tmpl[i:])
+			break
+		}
+		name, pattern := splitVariable(tmpl[i+1 : end])
+		path.WriteString("{" + name + "}")
+		params = append(params, Param{
+			Name:     name,
+			In:       "path",
+			Required: true,
+			Schema:   &Schema{Type: "string", Pattern: anchorPattern(pattern)},
+		})
+		i = end
+	}
+	return path.String(), params
+}
+
+// convertQueryTemplate converts a mux query template, ex: q={query}, into a required query parameter
+func convertQueryTemplate(q string) Param {
+	split := strings.SplitN(q, "=", 2)
+	p := Param{Name: split[0], In: "query", Required: true, Schema: &Schema{Type: "string"}}
+	if len(split) == 2 {
+		v := split[1]
+		if strings.HasPrefix(v, "{") && matchingBrace(v, 0) == len(v)-1 {
+			_, pattern := splitVariable(v[1 : len(v)-1])
+			p.Schema.Pattern = anchorPattern(pattern)
+		} else if v != "" {
+			p.Schema.Enum = []string{v}
+		}
+	}
+	return p
+}
+
+func matchingBrace(s string, start int) int {
+	depth := 0
+	for i := start; i < len(s); i++ {
+		switch s[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func splitVariable(v string) (string, string) {
+	split := strings.SplitN(v, ":", 2)
+	if len(split) == 2 {
+		return split[0], split[1]
+	}
+	return split[0], ""
+}
+
+func anchorPattern(pattern string) string {
+	if pattern == "" {
+		return ""
+	}
+	return "^" + pattern + "$"
+}
+
+func handleOpenApi(w http.ResponseWriter, r *http.Request) {
+	doc, err := buildOpenApi(rootMux)
+	if err != nil {
+		log.Error("unable to build openapi document", logger.Attrs{"err": err})
+		RespondJSON(r.Context(), w, http.StatusInternalServerError, map[string]interface{}{
+			"error": "unable to build openapi document",
+		})
+		return
+	}
+	RespondJSON(r.Context(), w, http.StatusOK, doc)
+}
+
+func handleExplorer(w http.ResponseWriter, r *http.Request) {
+	b, err := explorerBox.Find("index.html")
+	if err != nil {
+		log.Error("unable to load explorer", logger.Attrs{"err": err})
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(b)
+}
+
+func handleExplorerAsset(w http.ResponseWriter, r *http.Request) {
+	file := mux.Vars(r)["file"]
+	b, err := explorerBox.Find(file)
+	if err != nil {
+		log.Error("unable to load explorer asset", logger.Attrs{"err": err, "file": file})
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", mime.TypeByExtension(path.Ext(file)))
+	w.Header().Set("Cache-Control", maxAge(viper.GetDuration("oip.api.cache.pinnedMaxAge")))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(b)
+}
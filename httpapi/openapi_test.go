@@ -0,0 +1,107 @@
+package httpapi
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+)
+
+func TestConvertPathTemplate(t *testing.T) {
+	path, params := convertPathTemplate("/oip/o5/record/mapping/{tmpl:tmpl_[a-fA-F0-9]{8}(?:,tmpl_[a-fA-F0-9]{8})*}")
+	if path != "/oip/o5/record/mapping/{tmpl}" {
+		t.Errorf("unexpected path %s", path)
+	}
+	if len(params) != 1 || params[0].Name != "tmpl" || params[0].Schema.Pattern != "^tmpl_[a-fA-F0-9]{8}(?:,tmpl_[a-fA-F0-9]{8})*$" {
+		t.Errorf("unexpected params %+v", params)
+	}
+
+	path, params = convertPathTemplate("/oip/oip042/record/get/{originalTxid}/version/{editRecordTxid}")
+	if path != "/oip/oip042/record/get/{originalTxid}/version/{editRecordTxid}" {
+		t.Errorf("unexpected path %s", path)
+	}
+	if len(params) != 2 || params[1].Name != "editRecordTxid" || params[1].Schema.Pattern != "" {
+		t.Errorf("unexpected params %+v", params)
+	}
+}
+
+func TestConvertQueryTemplate(t *testing.T) {
+	p := convertQueryTemplate(`f={field:[a-zA-Z\.]+}`)
+	if p.Name != "f" || !p.Required || p.Schema.Pattern != `^[a-zA-Z\.]+$` {
+		t.Errorf("unexpected param %+v", p)
+	}
+}
+
+func TestBuildOpenApi(t *testing.T) {
+	m := mux.NewRouter().PathPrefix("/oip").Subrouter()
+	r := &Router{router: m.PathPrefix("/test").Subrouter(), tag: "test"}
+	h := func(w http.ResponseWriter, r *http.Request) {}
+
+	r.HandleFunc("/get/latest", h, RouteDoc{Summary: "filtered", Paged: true}).Queries("nsfw", "{nsfw}")
+	r.HandleFunc("/get/latest", h, RouteDoc{Summary: "unfiltered", Paged: true})
+	r.HandleFunc("/search", h, RouteDoc{Summary: "json", Body: searchRequestSchema}).Methods("POST")
+
+	doc, err := buildOpenApi(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	latest, ok := doc.Paths["/oip/test/get/latest"]["get"]
+	if !ok {
+		t.Fatal("missing /oip/test/get/latest")
+	}
+	if latest.Summary != "filtered" || latest.Tags[0] != "test" {
+		t.Errorf("unexpected operation %+v", latest)
+	}
+	var found bool
+	for _, p := range latest.Parameters {
+		if p.Name == "nsfw" {
+			found = true
+			if p.Required {
+				t.Error("nsfw should be optional once merged with the unfiltered route")
+			}
+		}
+	}
+	if !found {
+		t.Error("missing nsfw parameter")
+	}
+
+	search, ok := doc.Paths["/oip/test/search"]["post"]
+	if !ok {
+		t.Fatal("missing POST /oip/test/search")
+	}
+	if search.RequestBody == nil {
+		t.Error("missing request body")
+	}
+	if _, ok := doc.Paths["/oip/test/search"]["get"]; ok {
+		t.Error("unexpected GET /oip/test/search")
+	}
+}
+
+func TestSchemaOfRecursive(t *testing.T) {
+	s := SchemaOf(FacetRequest{})
+	facets := s.Properties["facets"]
+	if facets == nil || facets.AdditionalProperties == nil {
+		t.Fatalf("unexpected schema %+v", s)
+	}
+	if facets.AdditionalProperties.Properties["terms"] == nil {
+		t.Error("missing terms")
+	}
+	if facets.AdditionalProperties.Properties["facets"].AdditionalProperties.Type != "object" {
+		t.Error("recursive facets should be a plain object")
+	}
+}
+
+func TestExplorerAssets(t *testing.T) {
+	b, err := explorerBox.Find("index.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range regexp.MustCompile(`(?:href|src)="([^"]+)"`).FindAllStringSubmatch(string(b), -1) {
+		if !strings.HasPrefix(m[1], "explorer/") || !explorerBox.Has(strings.TrimPrefix(m[1], "explorer/")) {
+			t.Errorf("explorer asset %s is not bundled", m[1])
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package httpapi
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/mux"
+)
+
+// Router wraps a mux.Router so every registered route carries documentation
+type Router struct {
+	router *mux.Router
+	tag    string
+}
+
+// RouteDoc describes a route for the generated OpenAPI document
+// Path and query variables declared via mux templates are documented automatically
+type RouteDoc struct {
+	// Short summary of the route
+	Summary string
+	// Optional longer description, markdown permitted
+	Description string
+	// Additional parameters not declared in the mux templates, typically optional query parameters
+	Params []Param
+	// Route returns multiple results and accepts the common after, limit, page and sort parameters
+	Paged bool
+	// Schema of the json request body, if any
+	Body *Schema
+	// Schema of a successful response
+	Response *Schema
+	// Content type of a successful response, defaults to application/json
+	ContentType string
+	// Caching policy applied to successful GET responses
+	Cache CachePolicy
+}
+
+// Param describes a single path or query parameter
+type Param struct {
+	Name        string
+	In          string
+	Description string
+	Required    bool
+	Schema      *Schema
+}
+
+var (
+	routeDocsMutex sync.RWMutex
+	routeDocs      = make(map[*mux.Route]routeEntry)
+)
+
+type routeEntry struct {
+	doc RouteDoc
+	tag string
+}
+
+// NewSubRoute creates a documented sub-router under /oip
+func NewSubRoute(prefix string) *Router {
+	return &Router{
+		router: rootMux.PathPrefix(prefix).Subrouter(),
+		tag:    strings.Trim(prefix, "/"),
+	}
+}
+
+// HandleFunc registers a new route with a matcher for the path along with its documentation
+// The returned mux.Route may be further restricted with Methods, Queries, etc
+func (r *Router) HandleFunc(path string, f func(http.ResponseWriter, *http.Request), doc RouteDoc) *mux.Route {
+	route := r.router.HandleFunc(path, func(w http.ResponseWriter, req *http.Request) {
+		policy := doc.Cache
+		if policy == CachePinned && !pinnedVars(mux.Vars(req)) {
+			// prefix lookups may match additional content later
+			policy = CacheShort
+		}
+		f(w, withCacheState(req, policy))
+	})
+	routeDocsMutex.Lock()
+	routeDocs[route] = routeEntry{doc: doc, tag: r.tag}
+	routeDocsMutex.Unlock()
+	return route
+}
+
+func getRouteEntry(route *mux.Route) (routeEntry, bool) {
+	routeDocsMutex.RLock()
+	defer routeDocsMutex.RUnlock()
+	e, ok := routeDocs[route]
+	return e, ok
+}
@@ -0,0 +1,388 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/olivere/elastic.v6"
+)
+
+// FieldType describes which operators a whitelisted field may be used with
+type FieldType int
+
+const (
+	KeywordField FieldType = iota
+	TextField
+	NumericField
+	DateField
+	BoolField
+	GeoPointField
+	// DynamicField is used for wildcard entries such as oip5 template details
+	// where the mapping is not known ahead of time
+	DynamicField
+)
+
+// SearchFields is the allow-list of fields which may be referenced by a search request
+// Keys ending in `*` allow any field sharing the prefix
+type SearchFields map[string]FieldType
+
+const (
+	maxQueryDepth   = 8
+	maxQueryClauses = 64
+)
+
+var dynamicFieldRe = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// Lookup returns the type of a field if it is present in the allow-list
+func (sf SearchFields) Lookup(field string) (FieldType, bool) {
+	if ft, ok := sf[field]; ok {
+		return ft, true
+	}
+	if !dynamicFieldRe.MatchString(field) {
+		return 0, false
+	}
+	for k, ft := range sf {
+		if strings.HasSuffix(k, "*") && strings.HasPrefix(field, strings.TrimSuffix(k, "*")) {
+			return ft, true
+		}
+	}
+	return 0, false
+}
+
+// SearchRequest is the body accepted by POST search endpoints
+type SearchRequest struct {
+	Query json.RawMessage `json:"query"`
+}
+
+type dslNode map[string]json.RawMessage
+
+type dslField struct {
+	Field string `json:"field"`
+}
+
+type dslValue struct {
+	Field string      `json:"field"`
+	Value interface{} `json:"value"`
+}
+
+type dslValues struct {
+	Field  string        `json:"field"`
+	Values []interface{} `json:"values"`
+}
+
+type dslRange struct {
+	Field string      `json:"field"`
+	Gt    interface{} `json:"gt"`
+	Gte   interface{} `json:"gte"`
+	Lt    interface{} `json:"lt"`
+	Lte   interface{} `json:"lte"`
+}
+
+type dslMatch struct {
+	Field    string `json:"field"`
+	Text     string `json:"text"`
+	Operator string `json:"operator"`
+}
+
+type dslGeoPoint struct {
+	Lat float64 `json:"lat"`
+	Lon float64 `json:"lon"`
+}
+
+type dslGeoDistance struct {
+	Field    string      `json:"field"`
+	Point    dslGeoPoint `json:"point"`
+	Distance string      `json:"distance"`
+}
+
+type dslGeoBox struct {
+	Field       string      `json:"field"`
+	TopLeft     dslGeoPoint `json:"top_left"`
+	BottomRight dslGeoPoint `json:"bottom_right"`
+}
+
+type dslBool struct {
+	Must               []json.RawMessage `json:"must"`
+	Should             []json.RawMessage `json:"should"`
+	MustNot            []json.RawMessage `json:"must_not"`
+	Filter             []json.RawMessage `json:"filter"`
+	MinimumShouldMatch int               `json:"minimum_should_match"`
+}
+
+type dslParser struct {
+	fields  SearchFields
+	clauses int
+}
+
+// ParseSearchQuery translates the constrained JSON query language into an elastic query
+// Only fields present within fields may be referenced
+func ParseSearchQuery(raw json.RawMessage, fields SearchFields) (elastic.Query, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	p := &dslParser{fields: fields}
+	return p.parse(raw, 0)
+}
+
+func (p *dslParser) parse(raw json.RawMessage, depth int) (elastic.Query, error) {
+	if depth > maxQueryDepth {
+		return nil, errors.New("query nested too deeply")
+	}
+	p.clauses++
+	if p.clauses > maxQueryClauses {
+		return nil, errors.New("query contains too many clauses")
+	}
+
+	var node dslNode
+	err := json.Unmarshal(raw, &node)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid query clause")
+	}
+	if len(node) != 1 {
+		return nil, errors.New("query clause must contain exactly one operator")
+	}
+
+	for op, body := range node {
+		switch op {
+		case "term":
+			return p.term(body)
+		case "terms":
+			return p.terms(body)
+		case "range":
+			return p.rangeQuery(body)
+		case "prefix":
+			return p.prefix(body)
+		case "exists":
+			return p.exists(body)
+		case "match":
+			return p.match(body)
+		case "geo_distance":
+			return p.geoDistance(body)
+		case "geo_bounding_box":
+			return p.geoBox(body)
+		case "bool":
+			return p.boolQuery(body, depth)
+		default:
+			return nil, fmt.Errorf("unsupported operator %q", op)
+		}
+	}
+	return nil, errors.New("empty query clause")
+}
+
+func (p *dslParser) checkField(field string, op string, allowed ...FieldType) error {
+	ft, ok := p.fields.Lookup(field)
+	if !ok {
+		return fmt.Errorf("field %q is not searchable", field)
+	}
+	if ft == DynamicField {
+		return nil
+	}
+	for _, a := range allowed {
+		if ft == a {
+			return nil
+		}
+	}
+	return fmt.Errorf("operator %q not supported on field %q", op, field)
+}
+
+func (p *dslParser) term(body json.RawMessage) (elastic.Query, error) {
+	var v dslValue
+	if err := json.Unmarshal(body, &v); err != nil {
+		return nil, errors.Wrap(err, "invalid term")
+	}
+	if err := p.checkField(v.Field, "term", KeywordField, NumericField, DateField, BoolField); err != nil {
+		return nil, err
+	}
+	if !isScalar(v.Value) {
+		return nil, errors.New("term value must be a string, number or boolean")
+	}
+	return elastic.NewTermQuery(v.Field, v.Value), nil
+}
+
+func (p *dslParser) terms(body json.RawMessage) (elastic.Query, error) {
+	var v dslValues
+	if err := json.Unmarshal(body, &v); err != nil {
+		return nil, errors.Wrap(err, "invalid terms")
+	}
+	if err := p.checkField(v.Field, "terms", KeywordField, NumericField, DateField, BoolField); err != nil {
+		return nil, err
+	}
+	if len(v.Values) == 0 || len(v.Values) > maxQueryClauses {
+		return nil, fmt.Errorf("terms requires between 1 and %d values", maxQueryClauses)
+	}
+	for _, val := range v.Values {
+		if !isScalar(val) {
+			return nil, errors.New("terms values must be strings, numbers or booleans")
+		}
+	}
+	return elastic.NewTermsQuery(v.Field, v.Values...), nil
+}
+
+func (p *dslParser) rangeQuery(body json.RawMessage) (elastic.Query, error) {
+	var v dslRange
+	if err := json.Unmarshal(body, &v); err != nil {
+		return nil, errors.Wrap(err, "invalid range")
+	}
+	if err := p.checkField(v.Field, "range", NumericField, DateField, KeywordField); err != nil {
+		return nil, err
+	}
+	if v.Gt == nil && v.Gte == nil && v.Lt == nil && v.Lte == nil {
+		return nil, errors.New("range requires at least one bound")
+	}
+	q := elastic.NewRangeQuery(v.Field)
+	for _, b := range []interface{}{v.Gt, v.Gte, v.Lt, v.Lte} {
+		if b != nil && !isScalar(b) {
+			return nil, errors.New("range bounds must be strings or numbers")
+		}
+	}
+	if v.Gt != nil {
+		q.Gt(v.Gt)
+	}
+	if v.Gte != nil {
+		q.Gte(v.Gte)
+	}
+	if v.Lt != nil {
+		q.Lt(v.Lt)
+	}
+	if v.Lte != nil {
+		q.Lte(v.Lte)
+	}
+	return q, nil
+}
+
+func (p *dslParser) prefix(body json.RawMessage) (elastic.Query, error) {
+	var v dslValue
+	if err := json.Unmarshal(body, &v); err != nil {
+		return nil, errors.Wrap(err, "invalid prefix")
+	}
+	if err := p.checkField(v.Field, "prefix", KeywordField); err != nil {
+		return nil, err
+	}
+	s, ok := v.Value.(string)
+	if !ok || s == "" {
+		return nil, errors.New("prefix value must be a non-empty string")
+	}
+	return elastic.NewPrefixQuery(v.Field, s), nil
+}
+
+func (p *dslParser) exists(body json.RawMessage) (elastic.Query, error) {
+	var v dslField
+	if err := json.Unmarshal(body, &v); err != nil {
+		return nil, errors.Wrap(err, "invalid exists")
+	}
+	if _, ok := p.fields.Lookup(v.Field); !ok {
+		return nil, fmt.Errorf("field %q is not searchable", v.Field)
+	}
+	return elastic.NewExistsQuery(v.Field), nil
+}
+
+func (p *dslParser) match(body json.RawMessage) (elastic.Query, error) {
+	var v dslMatch
+	if err := json.Unmarshal(body, &v); err != nil {
+		return nil, errors.Wrap(err, "invalid match")
+	}
+	if err := p.checkField(v.Field, "match", TextField); err != nil {
+		return nil, err
+	}
+	if v.Text == "" {
+		return nil, errors.New("match text must not be empty")
+	}
+	q := elastic.NewMatchQuery(v.Field, v.Text)
+	switch strings.ToLower(v.Operator) {
+	case "":
+	case "and", "or":
+		q.Operator(strings.ToLower(v.Operator))
+	default:
+		return nil, errors.New("match operator must be 'and' or 'or'")
+	}
+	return q, nil
+}
+
+func (p *dslParser) geoDistance(body json.RawMessage) (elastic.Query, error) {
+	var v dslGeoDistance
+	if err := json.Unmarshal(body, &v); err != nil {
+		return nil, errors.Wrap(err, "invalid geo_distance")
+	}
+	if err := p.checkField(v.Field, "geo_distance", GeoPointField); err != nil {
+		return nil, err
+	}
+	if !validLatLon(v.Point) {
+		return nil, errors.New("geo_distance point out of range")
+	}
+	if v.Distance == "" {
+		return nil, errors.New("geo_distance requires a distance")
+	}
+	return elastic.NewGeoDistanceQuery(v.Field).Lat(v.Point.Lat).Lon(v.Point.Lon).Distance(v.Distance), nil
+}
+
+func (p *dslParser) geoBox(body json.RawMessage) (elastic.Query, error) {
+	var v dslGeoBox
+	if err := json.Unmarshal(body, &v); err != nil {
+		return nil, errors.Wrap(err, "invalid geo_bounding_box")
+	}
+	if err := p.checkField(v.Field, "geo_bounding_box", GeoPointField); err != nil {
+		return nil, err
+	}
+	if !validLatLon(v.TopLeft) || !validLatLon(v.BottomRight) {
+		return nil, errors.New("geo_bounding_box corner out of range")
+	}
+	return elastic.NewGeoBoundingBoxQuery(v.Field).
+		TopLeft(v.TopLeft.Lat, v.TopLeft.Lon).
+		BottomRight(v.BottomRight.Lat, v.BottomRight.Lon), nil
+}
+
+func (p *dslParser) boolQuery(body json.RawMessage, depth int) (elastic.Query, error) {
+	var v dslBool
+	if err := json.Unmarshal(body, &v); err != nil {
+		return nil, errors.Wrap(err, "invalid bool")
+	}
+	q := elastic.NewBoolQuery()
+	empty := true
+
+	add := func(clauses []json.RawMessage, fn func(...elastic.Query) *elastic.BoolQuery) error {
+		for _, c := range clauses {
+			sub, err := p.parse(c, depth+1)
+			if err != nil {
+				return err
+			}
+			fn(sub)
+			empty = false
+		}
+		return nil
+	}
+
+	if err := add(v.Must, q.Must); err != nil {
+		return nil, err
+	}
+	if err := add(v.Should, q.Should); err != nil {
+		return nil, err
+	}
+	if err := add(v.MustNot, q.MustNot); err != nil {
+		return nil, err
+	}
+	if err := add(v.Filter, q.Filter); err != nil {
+		return nil, err
+	}
+	if empty {
+		return nil, errors.New("bool requires at least one clause")
+	}
+	if v.MinimumShouldMatch > 0 {
+		q.MinimumNumberShouldMatch(v.MinimumShouldMatch)
+	}
+	return q, nil
+}
+
+func isScalar(v interface{}) bool {
+	switch v.(type) {
+	case string, float64, bool:
+		return true
+	}
+	return false
+}
+
+func validLatLon(p dslGeoPoint) bool {
+	return p.Lat >= -90 && p.Lat <= 90 && p.Lon >= -180 && p.Lon <= 180
+}
@@ -0,0 +1,117 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+var testSearchFields = SearchFields{
+	"meta.txid":        KeywordField,
+	"meta.block":       NumericField,
+	"meta.time":        DateField,
+	"meta.latest":      BoolField,
+	"info.title":       TextField,
+	"location":         GeoPointField,
+	"record.details.*": DynamicField,
+}
+
+func TestParseSearchQuery(t *testing.T) {
+	cases := []struct {
+		name     string
+		query    string
+		expected string
+		err      bool
+	}{
+		{
+			name:     "term",
+			query:    `{"term":{"field":"meta.txid","value":"abc"}}`,
+			expected: `{"term":{"meta.txid":"abc"}}`,
+		},
+		{
+			name:     "range",
+			query:    `{"range":{"field":"meta.block","gte":10,"lt":20}}`,
+			expected: `{"range":{"meta.block":{"from":10,"include_lower":true,"include_upper":false,"to":20}}}`,
+		},
+		{
+			name:     "prefix",
+			query:    `{"prefix":{"field":"meta.txid","value":"ab"}}`,
+			expected: `{"prefix":{"meta.txid":"ab"}}`,
+		},
+		{
+			name:     "exists",
+			query:    `{"exists":{"field":"info.title"}}`,
+			expected: `{"exists":{"field":"info.title"}}`,
+		},
+		{
+			name:     "match",
+			query:    `{"match":{"field":"info.title","text":"hello world","operator":"and"}}`,
+			expected: `{"match":{"info.title":{"operator":"and","query":"hello world"}}}`,
+		},
+		{
+			name:     "bool",
+			query:    `{"bool":{"must":[{"term":{"field":"meta.latest","value":true}}],"must_not":[{"prefix":{"field":"meta.txid","value":"00"}}]}}`,
+			expected: `{"bool":{"must":{"term":{"meta.latest":true}},"must_not":{"prefix":{"meta.txid":"00"}}}}`,
+		},
+		{
+			name:     "dynamic field",
+			query:    `{"term":{"field":"record.details.tmpl_DEADBEEF.name","value":"x"}}`,
+			expected: `{"term":{"record.details.tmpl_DEADBEEF.name":"x"}}`,
+		},
+		{name: "unknown field", query: `{"term":{"field":"meta.secret","value":"x"}}`, err: true},
+		{name: "unknown operator", query: `{"query_string":{"query":"*"}}`, err: true},
+		{name: "two operators", query: `{"term":{"field":"meta.txid","value":"a"},"exists":{"field":"meta.txid"}}`, err: true},
+		{name: "match on keyword", query: `{"match":{"field":"meta.txid","text":"a"}}`, err: true},
+		{name: "prefix on numeric", query: `{"prefix":{"field":"meta.block","value":"1"}}`, err: true},
+		{name: "range without bounds", query: `{"range":{"field":"meta.block"}}`, err: true},
+		{name: "term with object", query: `{"term":{"field":"meta.txid","value":{"a":1}}}`, err: true},
+		{name: "empty bool", query: `{"bool":{}}`, err: true},
+		{name: "geo out of range", query: `{"geo_distance":{"field":"location","point":{"lat":91,"lon":0},"distance":"1km"}}`, err: true},
+		{name: "dynamic field injection", query: `{"term":{"field":"record.details.a b","value":"x"}}`, err: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			q, err := ParseSearchQuery(json.RawMessage(c.query), testSearchFields)
+			if c.err {
+				if err == nil {
+					t.Fatalf("expected error for %s", c.query)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			src, err := q.Source()
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := json.Marshal(src)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != c.expected {
+				t.Errorf("expected %s, received %s", c.expected, string(b))
+			}
+		})
+	}
+}
+
+func TestParseSearchQueryLimits(t *testing.T) {
+	q := `{"exists":{"field":"meta.txid"}}`
+	for i := 0; i < maxQueryDepth+1; i++ {
+		q = `{"bool":{"must":[` + q + `]}}`
+	}
+	_, err := ParseSearchQuery(json.RawMessage(q), testSearchFields)
+	if err == nil {
+		t.Error("expected depth limit error")
+	}
+
+	clauses := `{"exists":{"field":"meta.txid"}}`
+	for i := 0; i < maxQueryClauses; i++ {
+		clauses += `,{"exists":{"field":"meta.txid"}}`
+	}
+	_, err = ParseSearchQuery(json.RawMessage(`{"bool":{"should":[`+clauses+`]}}`), testSearchFields)
+	if err == nil {
+		t.Error("expected clause limit error")
+	}
+}
@@ -0,0 +1,137 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/azer/logger"
+	"github.com/pkg/errors"
+	"gopkg.in/olivere/elastic.v6"
+)
+
+const maxSearchBodySize = 64 * 1024
+
+var errRequestTooLarge = errors.New("request body too large")
+
+// SearchResource describes an index (or set of indices) which may be queried via the json search language
+type SearchResource struct {
+	// Indices queried, prefixed via datastore.Index
+	Indices []string
+	// Allow-list of fields which may be referenced by a query
+	Fields SearchFields
+	// Filters always applied in addition to the user query, ex: meta.deactivated:false
+	Filters []elastic.Query
+	// Default sorts appended after any user provided sort
+	Sorts []elastic.SortInfo
+	// Fields to be returned
+	Fsc *elastic.FetchSourceContext
+}
+
+// BuildQuery combines the resource filters with the provided user query
+func (sr *SearchResource) BuildQuery(userQuery elastic.Query) elastic.Query {
+	q := elastic.NewBoolQuery()
+	if userQuery != nil {
+		q.Must(userQuery)
+	}
+	if len(sr.Filters) > 0 {
+		q.Filter(sr.Filters...)
+	}
+	if userQuery == nil && len(sr.Filters) == 0 {
+		return elastic.NewMatchAllQuery()
+	}
+	return q
+}
+
+// ParseSearchRequest reads a json search request from the body of r and translates it into an elastic query
+func (sr *SearchResource) ParseSearchRequest(r *http.Request) (elastic.Query, error) {
+	var req SearchRequest
+	err := decodeJsonBody(r, &req)
+	if err != nil {
+		return nil, err
+	}
+	uq, err := ParseSearchQuery(req.Query, sr.Fields)
+	if err != nil {
+		return nil, err
+	}
+	return sr.BuildQuery(uq), nil
+}
+
+// HandleSearch is an http.HandlerFunc executing a json search request against the resource
+func (sr *SearchResource) HandleSearch(w http.ResponseWriter, r *http.Request) {
+	query, err := sr.ParseSearchRequest(r)
+	if err != nil {
+		log.Info("invalid search request", logger.Attrs{"err": err, "url": r.URL})
+		RespondJSON(r.Context(), w, http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	searchService := BuildCommonSearchService(
+		r.Context(),
+		sr.Indices,
+		query,
+		sr.Sorts,
+		sr.Fsc,
+	)
+
+	RespondSearch(r.Context(), w, searchService)
+}
+
+func decodeJsonBody(r *http.Request, v interface{}) error {
+	if r.Body == nil {
+		return nil
+	}
+	b, err := ioutil.ReadAll(io.LimitReader(r.Body, maxSearchBodySize+1))
+	if err != nil {
+		return err
+	}
+	if len(b) > maxSearchBodySize {
+		return errRequestTooLarge
+	}
+	if len(b) == 0 {
+		return nil
+	}
+	return json.Unmarshal(b, v)
+}
+
+var searchRequestSchema = ObjectSchema(map[string]*Schema{
+	"query": {Type: "object", Description: "json search query, see the Json Search section of api.md"},
+})
+
+// SearchDoc documents a route served by HandleSearch
+func (sr *SearchResource) SearchDoc(summary string) RouteDoc {
+	return RouteDoc{
+		Summary:  summary,
+		Paged:    true,
+		Body:     searchRequestSchema,
+		Response: SearchResponse(nil),
+	}
+}
+
+// FacetsDoc documents a route served by HandleFacets
+func (sr *SearchResource) FacetsDoc(summary string) RouteDoc {
+	return RouteDoc{
+		Summary: summary,
+		Body:    SchemaOf(FacetRequest{}),
+		Response: ObjectSchema(map[string]*Schema{
+			"total":  {Type: "integer"},
+			"facets": SchemaOf(map[string]*FacetResult{}),
+		}),
+	}
+}
+
+// ExportDoc documents a route served by HandleExport
+func (sr *SearchResource) ExportDoc(summary string) RouteDoc {
+	return RouteDoc{
+		Summary: summary,
+		Params: []Param{
+			{Name: "format", Description: "ndjson or csv", Schema: &Schema{Type: "string", Enum: []string{"ndjson", "csv"}}},
+			{Name: "fields", Description: "comma separated list of fields to export"},
+		},
+		Body:        searchRequestSchema,
+		ContentType: "application/x-ndjson",
+	}
+}
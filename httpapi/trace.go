@@ -0,0 +1,147 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/azer/logger"
+	"github.com/gorilla/mux"
+	"gopkg.in/olivere/elastic.v6"
+
+	"github.com/oipwg/oip/datastore"
+	"github.com/oipwg/oip/events"
+	"github.com/oipwg/oip/trace"
+)
+
+// maximum later transactions reported per reference
+const traceReferenceLimit = 100
+
+type traceReference struct {
+	index string
+	field string
+}
+
+var (
+	traceReferencesMutex sync.RWMutex
+	traceReferences      []traceReference
+)
+
+// RegisterTraceReference declares that documents of index may reference an earlier transaction via field
+// Traces list every such document referencing the traced txid, ex: edits and deactivations
+func RegisterTraceReference(index, field string) {
+	traceReferencesMutex.Lock()
+	traceReferences = append(traceReferences, traceReference{index: index, field: field})
+	traceReferencesMutex.Unlock()
+}
+
+// TraceReference is a later transaction which references the traced transaction
+type TraceReference struct {
+	Index string `json:"index"`
+	Field string `json:"field"`
+	Txid  string `json:"txid"`
+	Block int64  `json:"block"`
+}
+
+var traceResponseSchema = ObjectSchema(map[string]*Schema{
+	"txid":       {Type: "string"},
+	"block":      {Type: "integer"},
+	"floData":    {Type: "string"},
+	"steps":      ArraySchema(SchemaOf(trace.Step{})),
+	"references": ArraySchema(SchemaOf(TraceReference{})),
+})
+
+func init() {
+	daemonRoutes.HandleFunc("/trace/{txid:[a-f0-9]{64}}", handleTrace, RouteDoc{
+		Summary: "Re-process a stored transaction in dry-run mode and return its decision tree",
+		Description: "Every event published, handler executed, document which would be written and rejection " +
+			"is listed in `steps`. Nothing is written to the datastore. " +
+			"`references` lists later transactions, such as edits and deactivations, referring to the transaction.",
+		Cache:    CacheNone,
+		Response: traceResponseSchema,
+	})
+}
+
+func handleTrace(w http.ResponseWriter, r *http.Request) {
+	txid := mux.Vars(r)["txid"]
+
+	td, err := datastore.GetTransactionFromID(r.Context(), txid)
+	if err == datastore.ErrNotFound {
+		RespondJSON(r.Context(), w, http.StatusNotFound, map[string]interface{}{
+			"error": "transaction not found",
+		})
+		return
+	}
+	if err != nil {
+		log.Error("unable to load transaction for trace", logger.Attrs{"err": err, "txid": txid})
+		RespondESError(r.Context(), w, err)
+		return
+	}
+
+	t := trace.New(txid)
+	if td.Transaction != nil && td.Transaction.FloData != "" {
+		td.Trace = t
+		events.Publish("flo:floData", td.Transaction.FloData, &td)
+		td.Trace = nil
+	} else {
+		t.Note("transaction has no floData")
+	}
+
+	refs, err := queryTraceReferences(r.Context(), txid)
+	if err != nil {
+		log.Error("unable to query trace references", logger.Attrs{"err": err, "txid": txid})
+		RespondESError(r.Context(), w, err)
+		return
+	}
+
+	var floData string
+	if td.Transaction != nil {
+		floData = td.Transaction.FloData
+	}
+	RespondJSON(r.Context(), w, http.StatusOK, map[string]interface{}{
+		"txid":       txid,
+		"block":      td.Block,
+		"floData":    floData,
+		"steps":      t.Steps,
+		"references": refs,
+	})
+}
+
+func queryTraceReferences(ctx context.Context, txid string) ([]TraceReference, error) {
+	traceReferencesMutex.RLock()
+	trs := append([]traceReference(nil), traceReferences...)
+	traceReferencesMutex.RUnlock()
+
+	refs := []TraceReference{}
+	fsc := elastic.NewFetchSourceContext(true).Include("meta.txid", "meta.block")
+	for _, tr := range trs {
+		res, err := datastore.Client().Search(datastore.Index(tr.index)).
+			Type("_doc").
+			IgnoreUnavailable(true).
+			Query(elastic.NewTermQuery(tr.field, txid)).
+			FetchSourceContext(fsc).
+			SortBy(elastic.NewFieldSort("meta.block").Asc().UnmappedType("long")).
+			Size(traceReferenceLimit).
+			Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, hit := range res.Hits.Hits {
+			var src struct {
+				Meta struct {
+					Txid  string `json:"txid"`
+					Block int64  `json:"block"`
+				} `json:"meta"`
+			}
+			if hit.Source != nil {
+				_ = json.Unmarshal(*hit.Source, &src)
+			}
+			if src.Meta.Txid == "" {
+				src.Meta.Txid = hit.Id
+			}
+			refs = append(refs, TraceReference{Index: tr.index, Field: tr.field, Txid: src.Meta.Txid, Block: src.Meta.Block})
+		}
+	}
+	return refs, nil
+}
@@ -0,0 +1,26 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Namespace prefixes every metric exported by oipd
+const Namespace = "oipd"
+
+var moduleMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: Namespace,
+	Subsystem: "module",
+	Name:      "messages_total",
+	Help:      "Messages processed by each module, by result",
+}, []string{"module", "result"})
+
+// Accepted counts a message successfully processed by a module
+func Accepted(module string) {
+	moduleMessages.WithLabelValues(module, "accepted").Inc()
+}
+
+// Rejected counts a message a module refused to process
+func Rejected(module string) {
+	moduleMessages.WithLabelValues(module, "rejected").Inc()
+}
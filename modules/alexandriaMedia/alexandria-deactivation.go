@@ -9,19 +9,31 @@ import (
 	"gopkg.in/olivere/elastic.v6"
 
 	"github.com/oipwg/oip/datastore"
-	"github.com/oipwg/oip/events"
+	"github.com/oipwg/oip/dispatch"
 	"github.com/oipwg/oip/flo"
+	"github.com/oipwg/oip/httpapi"
+	"github.com/oipwg/oip/metrics"
+	"github.com/oipwg/oip/modules/module"
+	"github.com/oipwg/oip/rejections"
 )
 
 const adIndexName = `alexandria-deactivation`
 
 var deactivationCommitMutex sync.Mutex
 
-func init() {
+func initDeactivation(hooks *module.Hooks) {
 	log.Info("init alexandria-deactivation")
-	events.SubscribeAsync("modules:oip:alexandriaDeactivation", onAlexandriaDeactivation)
-	events.SubscribeAsync("modules:oip:mpCompleted", onMpCompleted)
-	datastore.RegisterMapping(adIndexName, "alexandria-deactivation.json")
+	hooks.Subscribe("modules:oip:alexandriaDeactivation", onAlexandriaDeactivation)
+	hooks.Dispatch(dispatch.Route{
+		Name:     "alexandriaDeactivation",
+		Topic:    "modules:oip:alexandriaDeactivation",
+		Match:    dispatch.CompactPrefix(`{"alexandria-deactivation":`),
+		Priority: 70,
+		Mainnet:  &dispatch.Window{Min: 1000000, Max: 2400000},
+		Testnet:  &dispatch.Window{},
+	})
+	hooks.Subscribe("modules:oip:mpCompleted", onMpCompleted)
+	httpapi.RegisterTraceReference(adIndexName, "reference")
 }
 
 func onAlexandriaDeactivation(floData string, tx *datastore.TransactionData) {
@@ -29,6 +41,7 @@ func onAlexandriaDeactivation(floData string, tx *datastore.TransactionData) {
 	err := json.Unmarshal([]byte(floData), &ad)
 	if err != nil {
 		log.Error("unable to unmarshal json", logger.Attrs{"txid": tx.Transaction.Txid})
+		rejections.Reject(tx, "alexandriaDeactivation", rejections.StageUnmarshal, "invalid json", err)
 		return
 	}
 
@@ -36,6 +49,7 @@ func onAlexandriaDeactivation(floData string, tx *datastore.TransactionData) {
 	ok, err := flo.CheckSignature(ad.AlexandriaDeactivation.Address, ad.Signature, ad.AlexandriaDeactivation.Address+"-"+ad.AlexandriaDeactivation.Txid)
 	if !ok {
 		log.Error("signature validation failed", logger.Attrs{"txid": tx.Transaction.Txid, "err": err})
+		rejections.Reject(tx, "alexandriaDeactivation", rejections.StageSignature, "invalid signature", err)
 		return
 	}
 
@@ -55,7 +69,8 @@ func onAlexandriaDeactivation(floData string, tx *datastore.TransactionData) {
 	}
 
 	bir := elastic.NewBulkIndexRequest().Index(datastore.Index(adIndexName)).Type("_doc").Doc(ead).Id(tx.Transaction.Txid)
-	datastore.AutoBulk.Add(bir)
+	datastore.AutoBulk.AddFor(tx, bir)
+	metrics.Accepted("alexandriaDeactivation")
 }
 
 func onMpCompleted() {
@@ -10,20 +10,38 @@ import (
 	"gopkg.in/olivere/elastic.v6"
 
 	"github.com/oipwg/oip/datastore"
-	"github.com/oipwg/oip/events"
+	"github.com/oipwg/oip/dispatch"
 	"github.com/oipwg/oip/httpapi"
+	"github.com/oipwg/oip/metrics"
+	"github.com/oipwg/oip/modules/module"
 )
 
 const amIndexName = "alexandria-media"
 
-var artRouter = httpapi.NewSubRoute("/alexandria/artifact")
-
-func init() {
+func initMedia(hooks *module.Hooks) {
 	log.Info("init alexandria-media")
-	events.SubscribeAsync("modules:oip:alexandriaMedia", onAlexandriaMedia)
-	datastore.RegisterMapping(amIndexName, "alexandria-media.json")
-	artRouter.HandleFunc("/get/latest", handleLatest)
-	artRouter.HandleFunc("/get/{id:[a-f0-9]+}", handleGet)
+	hooks.Subscribe("modules:oip:alexandriaMedia", onAlexandriaMedia)
+	hooks.Dispatch(dispatch.Route{
+		Name:     "alexandriaMedia",
+		Topic:    "modules:oip:alexandriaMedia",
+		Match:    dispatch.CompactPrefix(`{"alexandria-media":`),
+		Priority: 60,
+		Mainnet:  &dispatch.Window{Min: 1000000, Max: 2400000},
+		Testnet:  &dispatch.Window{},
+	})
+}
+
+func mediaRoutes() {
+	artRouter := httpapi.NewSubRoute("/alexandria/artifact")
+	artRouter.HandleFunc("/get/latest", handleLatest, httpapi.RouteDoc{
+		Summary:  "Latest alexandria-media artifacts",
+		Paged:    true,
+		Response: httpapi.SearchResponse(nil),
+	})
+	artRouter.HandleFunc("/get/{id:[a-f0-9]+}", handleGet, httpapi.RouteDoc{
+		Summary:  "alexandria-media artifacts by txid or txid prefix",
+		Response: httpapi.SearchResponse(nil),
+	})
 }
 
 var (
@@ -84,7 +102,8 @@ func onAlexandriaMedia(floData string, tx *datastore.TransactionData) {
 		}
 
 		bir := elastic.NewBulkIndexRequest().Index(datastore.Index(amIndexName)).Type("_doc").Doc(el).Id(tx.Transaction.Txid)
-		datastore.AutoBulk.Add(bir)
+		datastore.AutoBulk.AddFor(tx, bir)
+		metrics.Accepted("alexandriaMedia")
 	} else {
 		log.Info("no title", attr)
 	}
@@ -9,20 +9,39 @@ import (
 	"gopkg.in/olivere/elastic.v6"
 
 	"github.com/oipwg/oip/datastore"
-	"github.com/oipwg/oip/events"
+	"github.com/oipwg/oip/dispatch"
 	"github.com/oipwg/oip/httpapi"
+	"github.com/oipwg/oip/metrics"
+	"github.com/oipwg/oip/modules/module"
+	"github.com/oipwg/oip/rejections"
 )
 
 const apIndexName = "alexandria-publisher"
 
-var pubRouter = httpapi.NewSubRoute("/alexandria/publisher")
-
-func init() {
+func initPublisher(hooks *module.Hooks) {
 	log.Info("init alexandria-publisher")
-	events.SubscribeAsync("modules:oip:alexandriaPublisher", onAlexandriaPublisher)
-	datastore.RegisterMapping(apIndexName, "alexandria-publisher.json")
-	pubRouter.HandleFunc("/get/latest/", handleLatestPublishers)
-	pubRouter.HandleFunc("/get/{address:[A-Za-z0-9]+}", handleGetPublisher)
+	hooks.Subscribe("modules:oip:alexandriaPublisher", onAlexandriaPublisher)
+	hooks.Dispatch(dispatch.Route{
+		Name:     "alexandriaPublisher",
+		Topic:    "modules:oip:alexandriaPublisher",
+		Match:    dispatch.CompactPrefix(`{"alexandria-publisher":`),
+		Priority: 80,
+		Mainnet:  &dispatch.Window{Min: 1000000},
+		Testnet:  &dispatch.Window{},
+	})
+}
+
+func publisherRoutes() {
+	pubRouter := httpapi.NewSubRoute("/alexandria/publisher")
+	pubRouter.HandleFunc("/get/latest/", handleLatestPublishers, httpapi.RouteDoc{
+		Summary:  "Latest alexandria publishers",
+		Paged:    true,
+		Response: httpapi.SearchResponse(nil),
+	})
+	pubRouter.HandleFunc("/get/{address:[A-Za-z0-9]+}", handleGetPublisher, httpapi.RouteDoc{
+		Summary:  "alexandria publisher by address",
+		Response: httpapi.SearchResponse(nil),
+	})
 }
 
 var (
@@ -67,9 +86,11 @@ func onAlexandriaPublisher(floData string, tx *datastore.TransactionData) {
 	pub := jsoniter.Get([]byte(floData), "alexandria-publisher")
 	if pub.LastError() != nil {
 		log.Error("invalid json", logger.Attrs{"floData": floData, "txid": tx.Transaction.Txid})
+		rejections.Reject(tx, "alexandriaPublisher", rejections.StageDecode, "invalid json", pub.LastError())
 		return
 	}
 
 	bir := elastic.NewBulkIndexRequest().Index(datastore.Index("alexandria-publisher")).Type("_doc").Doc(pub).Id(tx.Transaction.Txid)
-	datastore.AutoBulk.Add(bir)
+	datastore.AutoBulk.AddFor(tx, bir)
+	metrics.Accepted("alexandriaPublisher")
 }
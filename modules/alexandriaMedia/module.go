@@ -0,0 +1,46 @@
+package alexandriaMedia
+
+import (
+	"context"
+
+	"github.com/spf13/viper"
+
+	"github.com/oipwg/oip/modules/module"
+)
+
+type alexandriaMedia struct {
+	hooks module.Hooks
+}
+
+func init() {
+	module.Register(&alexandriaMedia{})
+}
+
+func (a *alexandriaMedia) Name() string {
+	return "alexandriaMedia"
+}
+
+func (a *alexandriaMedia) Mappings() map[string]string {
+	return map[string]string{
+		adIndexName: "alexandria-deactivation.json",
+		amIndexName: "alexandria-media.json",
+		apIndexName: "alexandria-publisher.json",
+	}
+}
+
+func (a *alexandriaMedia) Routes() {
+	mediaRoutes()
+	publisherRoutes()
+}
+
+func (a *alexandriaMedia) Init(ctx context.Context, cfg *viper.Viper) error {
+	initDeactivation(&a.hooks)
+	initMedia(&a.hooks)
+	initPublisher(&a.hooks)
+	return nil
+}
+
+func (a *alexandriaMedia) Shutdown(ctx context.Context) error {
+	a.hooks.Remove()
+	return nil
+}
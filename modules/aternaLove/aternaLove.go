@@ -1,45 +1,66 @@
 package aternaLove
 
 import (
+	"context"
 	"strings"
 
 	"github.com/azer/logger"
+	"github.com/spf13/viper"
 	"gopkg.in/olivere/elastic.v6"
 
 	"github.com/oipwg/oip/config"
 	"github.com/oipwg/oip/datastore"
-	"github.com/oipwg/oip/events"
+	"github.com/oipwg/oip/dispatch"
+	"github.com/oipwg/oip/metrics"
+	"github.com/oipwg/oip/modules/module"
+	"github.com/oipwg/oip/rejections"
 )
 
+type aternaLove struct {
+	hooks module.Hooks
+}
+
 func init() {
-	log.Info("init aterna")
-	if !config.IsTestnet() {
-		events.SubscribeAsync("flo:floData", onFloData)
-		events.SubscribeAsync("modules:aternaLove:alove", onAlove)
-		datastore.RegisterMapping("aterna", "aterna.json")
-	}
+	module.Register(&aternaLove{})
 }
 
-func onFloData(floData string, tx *datastore.TransactionData) {
-	if tx.Block < 500000 {
-		return
-	}
-	if tx.Block > 1000000 {
-		events.Unsubscribe("flo:floData", onFloData)
-		events.Unsubscribe("modules:aternaLove:alove", onAlove)
-	}
+func (a *aternaLove) Name() string {
+	return "aternaLove"
+}
 
-	prefix := "t1:ALOVE>"
-	if strings.HasPrefix(floData, prefix) {
-		events.Publish("modules:aternaLove:alove", strings.TrimPrefix(floData, prefix), tx)
-		return
-	}
+// EnabledByDefault as aterna messages were only published on mainnet
+func (a *aternaLove) EnabledByDefault() bool {
+	return !config.IsTestnet()
+}
+
+func (a *aternaLove) Mappings() map[string]string {
+	return map[string]string{"aterna": "aterna.json"}
+}
+
+func (a *aternaLove) Routes() {}
+
+func (a *aternaLove) Init(ctx context.Context, cfg *viper.Viper) error {
+	log.Info("init aterna")
+	a.hooks.Subscribe("modules:aternaLove:alove", onAlove)
+	a.hooks.Dispatch(dispatch.Route{
+		Name:    "aternaLove",
+		Topic:   "modules:aternaLove:alove",
+		Match:   dispatch.StripPrefix("t1:ALOVE>"),
+		Mainnet: &dispatch.Window{Min: 500000, Max: 1000001},
+	})
+	return nil
+}
+
+func (a *aternaLove) Shutdown(ctx context.Context) error {
+	a.hooks.Remove()
+	return nil
 }
 
 func onAlove(floData string, tx *datastore.TransactionData) {
 	chunks := strings.SplitN(floData, "|", 3)
 	if len(chunks) != 3 {
 		log.Error("invalid aterna", logger.Attrs{"txid": tx.Transaction.Txid, "floData": floData})
+		rejections.Reject(tx, "aternaLove", rejections.StageDecode, "expected message|to|from", nil)
 		return
 	}
 
@@ -50,7 +71,8 @@ func onAlove(floData string, tx *datastore.TransactionData) {
 		TxId:    tx.Transaction.Txid,
 	}
 	bir := elastic.NewBulkIndexRequest().Index(datastore.Index("aterna")).Type("_doc").Id(tx.Transaction.Txid).Doc(a)
-	datastore.AutoBulk.Add(bir)
+	datastore.AutoBulk.AddFor(tx, bir)
+	metrics.Accepted("aternaLove")
 }
 
 type Alove struct {
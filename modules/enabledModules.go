@@ -1,5 +1,7 @@
 package modules
 
+// Importing a module links it into the binary and registers it
+// Modules are enabled and configured via oip.modules in config.yml
 import (
 	_ "github.com/oipwg/oip/modules/alexandriaMedia"
 	_ "github.com/oipwg/oip/modules/aternaLove"
@@ -9,5 +11,7 @@ import (
 	_ "github.com/oipwg/oip/modules/oip041"
 	_ "github.com/oipwg/oip/modules/oip042"
 	_ "github.com/oipwg/oip/modules/oip5"
+	_ "github.com/oipwg/oip/modules/script"
 	_ "github.com/oipwg/oip/modules/tZero"
+	_ "github.com/oipwg/oip/modules/url"
 )
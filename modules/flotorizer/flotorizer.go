@@ -1,42 +1,66 @@
 package flotorizer
 
 import (
-	"strings"
+	"context"
 
+	"github.com/spf13/viper"
 	"gopkg.in/olivere/elastic.v6"
 
 	"github.com/oipwg/oip/config"
 	"github.com/oipwg/oip/datastore"
-	"github.com/oipwg/oip/events"
+	"github.com/oipwg/oip/dispatch"
+	"github.com/oipwg/oip/metrics"
+	"github.com/oipwg/oip/modules/module"
 )
 
+type flotorizer struct {
+	hooks module.Hooks
+}
+
 func init() {
+	module.Register(&flotorizer{})
+}
+
+func (f *flotorizer) Name() string {
+	return "flotorizer"
+}
+
+// EnabledByDefault as flotorizer messages were only published on mainnet
+func (f *flotorizer) EnabledByDefault() bool {
+	return !config.IsTestnet()
+}
+
+func (f *flotorizer) Mappings() map[string]string {
+	return map[string]string{"flotorizer": "flotorizer.json"}
+}
+
+func (f *flotorizer) Routes() {}
+
+func (f *flotorizer) Init(ctx context.Context, cfg *viper.Viper) error {
 	log.Info("init flotorizer")
-	if !config.IsTestnet() {
-		events.SubscribeAsync("flo:floData", onFloData)
-		events.SubscribeAsync("modules:flotorizer:flotorized", onFlotorized)
-		datastore.RegisterMapping("flotorizer", "flotorizer.json")
-	}
+	f.hooks.Subscribe("modules:flotorizer:flotorized", onFlotorized)
+	f.hooks.Dispatch(dispatch.Route{
+		Name:    "flotorizer",
+		Topic:   "modules:flotorizer:flotorized",
+		Match:   dispatch.StripPrefix("This document has been flotorized: "),
+		Mainnet: &dispatch.Window{Min: 1500000},
+	})
+	return nil
 }
 
-func onFloData(floData string, tx *datastore.TransactionData) {
-	if tx.Block < 1500000 {
-		return
-	}
-	prefix := "This document has been flotorized: "
-	if strings.HasPrefix(floData, prefix) {
-		events.Publish("modules:flotorizer:flotorized", strings.TrimPrefix(floData, prefix))
-		return
-	}
+func (f *flotorizer) Shutdown(ctx context.Context) error {
+	f.hooks.Remove()
+	return nil
 }
 
-func onFlotorized(floData string) {
+func onFlotorized(floData string, tx *datastore.TransactionData) {
 	f := Flotorized{
 		Hash: floData,
 		// TxId: txid,
 	}
 	bir := elastic.NewBulkIndexRequest().Index(datastore.Index("flotorizer")).Type("_doc"). /*Id(txid).*/ Doc(f)
-	datastore.AutoBulk.Add(bir)
+	datastore.AutoBulk.AddFor(tx, bir)
+	metrics.Accepted("flotorizer")
 }
 
 type Flotorized struct {
@@ -0,0 +1,19 @@
+//go:build go1.18
+// +build go1.18
+
+package historian
+
+import (
+	"testing"
+)
+
+func FuzzParseHdp(f *testing.F) {
+	f.Add(hdpAlexV1)
+	f.Add(hdpOipV3)
+	f.Fuzz(func(t *testing.T, s string) {
+		hdp, err := parseHdp(s)
+		if err == nil && (hdp.Version < 1 || hdp.Version > 3) {
+			t.Errorf("accepted data point with version %d", hdp.Version)
+		}
+	})
+}
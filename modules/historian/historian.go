@@ -1,6 +1,7 @@
 package historian
 
 import (
+	"context"
 	"errors"
 	"math"
 	"net/http"
@@ -12,28 +13,80 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/oipwg/proto/go/pb_historian"
 	"github.com/oipwg/proto/go/pb_oip"
+	"github.com/spf13/viper"
 	"gopkg.in/olivere/elastic.v6"
 
 	"github.com/oipwg/oip/datastore"
-	"github.com/oipwg/oip/events"
+	"github.com/oipwg/oip/dispatch"
 	"github.com/oipwg/oip/httpapi"
+	"github.com/oipwg/oip/metrics"
+	"github.com/oipwg/oip/modules/module"
+	"github.com/oipwg/oip/rejections"
 )
 
 const histDataPointIndexName = "historian_data_point_"
 
-var histRouter = httpapi.NewSubRoute("/historian")
+type historian struct {
+	hooks module.Hooks
+}
 
 func init() {
+	module.Register(&historian{})
+}
+
+func (h *historian) Name() string {
+	return "historian"
+}
+
+func (h *historian) Mappings() map[string]string {
+	return map[string]string{
+		histDataPointIndexName + "string": "historianDataPoint.json",
+		histDataPointIndexName + "proto":  "historianDataPoint.json",
+	}
+}
+
+func (h *historian) Init(ctx context.Context, cfg *viper.Viper) error {
 	log.Info("init historian")
-	events.SubscribeAsync("modules:historian:stringDataPoint", onStringHdp)
-	events.SubscribeAsync("modules:historian:protoDataPoint", onProtoHdp)
+	h.hooks.Subscribe("modules:historian:stringDataPoint", onStringHdp)
+	h.hooks.Subscribe("modules:historian:protoDataPoint", onProtoHdp)
+
+	// string data points were only published by miners in coinbase transactions
+	// other routes are still tested as pools may publish further messages
+	for _, prefix := range []string{"oip-historian-", "alexandria-historian-"} {
+		h.hooks.Dispatch(dispatch.Route{
+			Name:        "historian:" + strings.TrimSuffix(prefix, "-"),
+			Topic:       "modules:historian:stringDataPoint",
+			Match:       dispatch.CompactPrefix(prefix),
+			Priority:    100,
+			Mainnet:     &dispatch.Window{Min: 1000000, Max: 2731000},
+			Coinbase:    true,
+			Fallthrough: true,
+		})
+	}
+	return nil
+}
 
-	datastore.RegisterMapping(histDataPointIndexName+"string", "historianDataPoint.json")
-	datastore.RegisterMapping(histDataPointIndexName+"proto", "historianDataPoint.json")
+func (h *historian) Shutdown(ctx context.Context) error {
+	h.hooks.Remove()
+	return nil
+}
 
-	histRouter.HandleFunc("/get/latest", handleLatest)
-	histRouter.HandleFunc("/get/{id:[a-f0-9]+}", handleGet)
-	histRouter.HandleFunc("/24hr", handle24hr)
+func (h *historian) Routes() {
+	histRouter := httpapi.NewSubRoute("/historian")
+	histRouter.HandleFunc("/get/latest", handleLatest, httpapi.RouteDoc{
+		Summary:  "Latest historian data points",
+		Paged:    true,
+		Response: httpapi.SearchResponse(nil),
+	})
+	histRouter.HandleFunc("/get/{id:[a-f0-9]+}", handleGet, httpapi.RouteDoc{
+		Summary:  "Historian data points by txid or txid prefix",
+		Response: httpapi.SearchResponse(nil),
+		Cache:    httpapi.CachePinned,
+	})
+	histRouter.HandleFunc("/24hr", handle24hr, httpapi.RouteDoc{
+		Summary:  "Historian data points of the last 24 hours; not implemented",
+		Response: httpapi.ErrorResponse,
+	})
 }
 
 var (
@@ -93,11 +146,13 @@ func onStringHdp(floData string, tx *datastore.TransactionData) {
 	el, err := validateHdp(floData, tx)
 	if err != nil {
 		log.Error("validate historian dataPoint failed", logger.Attrs{"err": err})
+		rejections.Reject(tx, "historian", rejections.StageValidate, "invalid data point", err)
 		return
 	}
 
 	bir := elastic.NewBulkIndexRequest().Index(datastore.Index(histDataPointIndexName + "string")).Type("_doc").Id(tx.Transaction.Txid).Doc(el)
-	datastore.AutoBulk.Add(bir)
+	datastore.AutoBulk.AddFor(tx, bir)
+	metrics.Accepted("historian")
 }
 
 func onProtoHdp(msg *pb_oip.SignedMessage, tx *datastore.TransactionData) {
@@ -109,6 +164,7 @@ func onProtoHdp(msg *pb_oip.SignedMessage, tx *datastore.TransactionData) {
 	if err != nil {
 		attr["err"] = err
 		log.Error("unable to unmarshal protobuf historian message", attr)
+		rejections.Reject(tx, "historian", rejections.StageUnmarshal, "unable to unmarshal data point", err)
 		return
 	}
 
@@ -122,7 +178,8 @@ func onProtoHdp(msg *pb_oip.SignedMessage, tx *datastore.TransactionData) {
 		Txid:      tx.Transaction.Txid,
 	}
 	bir := elastic.NewBulkIndexRequest().Index(datastore.Index(histDataPointIndexName + "proto")).Type("_doc").Id(tx.Transaction.Txid).Doc(el)
-	datastore.AutoBulk.Add(bir)
+	datastore.AutoBulk.AddFor(tx, bir)
+	metrics.Accepted("historian")
 }
 
 type elasticHdp struct {
@@ -153,35 +210,66 @@ type DataPoint struct {
 type hdpV int
 
 const (
-	alexV1 = iota
-	oipV1  = iota
-	oipV2  = iota
-	oipV3  = iota
+	alexV1 hdpV = iota
+	oipV1
+	oipV2
+	oipV3
 )
 
+// minimum number of colon delimited parts read by each version
+var hdpMinParts = map[hdpV]int{
+	alexV1: 7,
+	oipV1:  7,
+	oipV2:  8,
+	oipV3:  9,
+}
+
 func validateHdp(floData string, tx *datastore.TransactionData) (elasticHdp, error) {
 	if tx.Block > 2731000 {
 		return elasticHdp{}, errors.New("deprecated")
 	}
 
-	// there are no bounds or error checks below since data points are no longer being published
-	// all historical datapoints are known valid
+	hdp, err := parseHdp(floData)
+	if err != nil {
+		return elasticHdp{}, err
+	}
+
 	var el elasticHdp
+	el.DataPoint = hdp
+	el.Meta = HMeta{
+		Block:     tx.Block,
+		BlockHash: tx.BlockHash,
+		Time:      tx.Transaction.Time,
+		Tx:        tx,
+		Txid:      tx.Transaction.Txid,
+	}
+
+	return el, nil
+}
+
+// parseHdp decodes a colon delimited string data point; numeric fields which
+// fail to parse are left as zero as they always have been
+func parseHdp(floData string) (DataPoint, error) {
 	var hdp DataPoint
 	var v hdpV
 
-	// alexandria-historian-v001
-	if floData[0] == 'a' {
-		v = alexV1
-		hdp.Version = 1
+	if len(floData) == 0 {
+		return hdp, errors.New("empty data point")
 	}
 
-	// oip-historian-3
-	// oip-historian-2
-	// oip-historian-1
-	if floData[0] == 'o' {
-		sv := floData[14]
-		switch sv {
+	switch floData[0] {
+	case 'a':
+		// alexandria-historian-v001
+		v = alexV1
+		hdp.Version = 1
+	case 'o':
+		// oip-historian-3
+		// oip-historian-2
+		// oip-historian-1
+		if len(floData) < 15 {
+			return hdp, errors.New("missing data point version")
+		}
+		switch floData[14] {
 		case '1':
 			v = oipV1
 			hdp.Version = 1
@@ -191,10 +279,17 @@ func validateHdp(floData string, tx *datastore.TransactionData) (elasticHdp, err
 		case '3':
 			v = oipV3
 			hdp.Version = 3
+		default:
+			return hdp, errors.New("unknown data point version")
 		}
+	default:
+		return hdp, errors.New("unknown data point prefix")
 	}
 
 	parts := strings.Split(floData, ":")
+	if len(parts) < hdpMinParts[v] {
+		return hdp, errors.New("data point has too few fields")
+	}
 
 	if v == alexV1 {
 		hdp.URL = parts[1]
@@ -225,14 +320,5 @@ func validateHdp(floData string, tx *datastore.TransactionData) (elasticHdp, err
 		hdp.CmcLtc, _ = strconv.ParseFloat(parts[i], 64)
 	}
 
-	el.DataPoint = hdp
-	el.Meta = HMeta{
-		Block:     tx.Block,
-		BlockHash: tx.BlockHash,
-		Time:      tx.Transaction.Time,
-		Tx:        tx,
-		Txid:      tx.Transaction.Txid,
-	}
-
-	return el, nil
+	return hdp, nil
 }
@@ -0,0 +1,42 @@
+package historian
+
+import (
+	"testing"
+)
+
+const (
+	hdpAlexV1 = "alexandria-historian-v001:pool.alexandria.io:0.000136008500:316.208:62.1153:2.48809e-05:0.000677113:IN9OrF1Jc8ydOr7ZOqk3d3S8Kos8HKNuSKMMd/w4TZzRbzbm2lLw/kv7xXUr9rl+uyd3jXsAOUUmafbsEmN+NbE="
+	hdpOipV3  = "oip-historian-3:FLmic78oU6eqXsTAaHGGdrFyY7FkzPfRTA:0.000137:0.000128:11.32:13.67:2345.2:0.0083:81.2:H2sT2X1bzS0p4N0/0ny9/8j5aSAdV4oG9j5M2d7DQd8oJt0a8mUQ4Ih3G5lP8cA0w6+fkq8C8vZ5Oq2bgb4O7rQ="
+)
+
+func TestParseHdp(t *testing.T) {
+	hdp, err := parseHdp(hdpAlexV1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hdp.Version != 1 || hdp.URL != "pool.alexandria.io" || hdp.FmdUsd != 0.000677113 {
+		t.Errorf("unexpected alexandria data point %+v", hdp)
+	}
+
+	hdp, err = parseHdp(hdpOipV3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hdp.Version != 3 || hdp.Address != "FLmic78oU6eqXsTAaHGGdrFyY7FkzPfRTA" || hdp.CmcLtc != 81.2 {
+		t.Errorf("unexpected oip data point %+v", hdp)
+	}
+
+	for _, s := range []string{
+		"",
+		"o",
+		"oip-historian-",
+		"oip-historian-4:addr:1:2:3:4:5:6:7:sig",
+		"oip-historian-3:addr:1:2:3",
+		"alexandria-historian-v001:url:1:2",
+		"x",
+	} {
+		if _, err := parseHdp(s); err == nil {
+			t.Errorf("expected error for %q", s)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package module
+
+import (
+	"net/http"
+
+	"github.com/oipwg/oip/httpapi"
+)
+
+var moduleRouter = httpapi.NewSubRoute("/modules")
+
+func init() {
+	moduleRouter.HandleFunc("/list", handleList, httpapi.RouteDoc{
+		Summary:     "Registered modules and whether each is enabled",
+		Description: "Modules are enabled via `oip.modules.<name>.enabled` in config.yml.",
+		Cache:       httpapi.CacheNone,
+		Response:    httpapi.ArraySchema(httpapi.SchemaOf(Info{})),
+	})
+}
+
+func handleList(w http.ResponseWriter, r *http.Request) {
+	httpapi.RespondJSON(r.Context(), w, http.StatusOK, List())
+}
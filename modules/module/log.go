@@ -0,0 +1,5 @@
+package module
+
+import "github.com/azer/logger"
+
+var log = logger.New("module")
@@ -0,0 +1,248 @@
+package module
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/azer/logger"
+	"github.com/spf13/viper"
+
+	"github.com/oipwg/oip/datastore"
+	"github.com/oipwg/oip/dispatch"
+	"github.com/oipwg/oip/events"
+)
+
+// Module is a message processor which may be enabled, disabled and configured via config.yml
+// Modules register themselves from an init func, nothing is subscribed or created until enabled
+type Module interface {
+	// Name of the module, also the key of its configuration under oip.modules
+	Name() string
+	// Mappings returns the mapping file of each index the module writes to, keyed by unprefixed index name
+	Mappings() map[string]string
+	// Routes registers the module http routes, called before the api is served
+	Routes()
+	// Init subscribes to events and registers dispatch routes, called once the datastore is ready
+	// cfg is the oip.modules.<name> section of the config
+	Init(ctx context.Context, cfg *viper.Viper) error
+	// Shutdown stops processing further messages
+	Shutdown(ctx context.Context) error
+}
+
+// Defaulter may be implemented by modules which should not be enabled unless configured
+type Defaulter interface {
+	EnabledByDefault() bool
+}
+
+// Preparer may be implemented by modules which must load resources before their mappings and routes are known
+type Preparer interface {
+	Prepare(cfg *viper.Viper) error
+}
+
+// Dependent may be implemented by modules which only receive messages via other modules
+type Dependent interface {
+	Requires() []string
+}
+
+var (
+	modulesMutex sync.Mutex
+	modules      = make(map[string]Module)
+	enabled      []Module
+	loaded       bool
+)
+
+// Register adds a module to the registry, registering a name a second time panics
+func Register(m Module) {
+	modulesMutex.Lock()
+	defer modulesMutex.Unlock()
+
+	name := strings.ToLower(m.Name())
+	if _, ok := modules[name]; ok {
+		panic(fmt.Sprintf("module %s registered twice", m.Name()))
+	}
+	modules[name] = m
+}
+
+// IsEnabled checks oip.modules.<name>.enabled falling back to the module default
+func IsEnabled(m Module) bool {
+	key := "oip.modules." + m.Name() + ".enabled"
+	if viper.IsSet(key) {
+		return viper.GetBool(key)
+	}
+	if d, ok := m.(Defaulter); ok {
+		return d.EnabledByDefault()
+	}
+	return true
+}
+
+// Load resolves the enabled modules, registering their mappings and routes
+// Must be called before the datastore is setup and the api is served
+func Load() error {
+	modulesMutex.Lock()
+	defer modulesMutex.Unlock()
+
+	if loaded {
+		return nil
+	}
+
+	var names []string
+	for name := range modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	enabled = enabled[:0]
+	for _, name := range names {
+		if m := modules[name]; IsEnabled(m) {
+			enabled = append(enabled, m)
+		}
+	}
+
+	for _, m := range enabled {
+		if d, ok := m.(Dependent); ok {
+			for _, req := range d.Requires() {
+				r, ok := modules[strings.ToLower(req)]
+				if !ok || !IsEnabled(r) {
+					return fmt.Errorf("module %s requires module %s to be enabled", m.Name(), req)
+				}
+			}
+		}
+	}
+
+	for _, m := range enabled {
+		log.Info("loading module", logger.Attrs{"module": m.Name()})
+		if p, ok := m.(Preparer); ok {
+			err := p.Prepare(moduleConfig(m))
+			if err != nil {
+				return fmt.Errorf("module %s prepare failed: %v", m.Name(), err)
+			}
+		}
+		for index, fileName := range m.Mappings() {
+			datastore.RegisterMapping(index, fileName)
+		}
+		m.Routes()
+	}
+	loaded = true
+
+	return nil
+}
+
+// Init initializes each enabled module with its configuration
+func Init(ctx context.Context) error {
+	for _, m := range loadedModules() {
+		err := m.Init(ctx, moduleConfig(m))
+		if err != nil {
+			return fmt.Errorf("module %s init failed: %v", m.Name(), err)
+		}
+		log.Info("initialized module", logger.Attrs{"module": m.Name()})
+	}
+	return nil
+}
+
+// Shutdown shuts down each enabled module in reverse order
+func Shutdown(ctx context.Context) {
+	ms := loadedModules()
+	for i := len(ms) - 1; i >= 0; i-- {
+		m := ms[i]
+		err := m.Shutdown(ctx)
+		if err != nil {
+			log.Error("module shutdown failed", logger.Attrs{"module": m.Name(), "err": err})
+		}
+	}
+}
+
+// moduleConfig returns the oip.modules.<name> section of the config
+// Keys are copied one by one as viper.Sub drops the defaults of a section
+// present in the config file
+func moduleConfig(m Module) *viper.Viper {
+	prefix := strings.ToLower("oip.modules." + m.Name() + ".")
+	cfg := viper.New()
+	for _, key := range viper.AllKeys() {
+		if strings.HasPrefix(key, prefix) {
+			cfg.Set(strings.TrimPrefix(key, prefix), viper.Get(key))
+		}
+	}
+	return cfg
+}
+
+func loadedModules() []Module {
+	modulesMutex.Lock()
+	defer modulesMutex.Unlock()
+
+	ms := make([]Module, len(enabled))
+	copy(ms, enabled)
+	return ms
+}
+
+// Info describes a registered module
+type Info struct {
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+	// Unprefixed names of the indices written by the module
+	Indices []string `json:"indices"`
+}
+
+// List describes every registered module ordered by name
+func List() []Info {
+	modulesMutex.Lock()
+	defer modulesMutex.Unlock()
+
+	infos := make([]Info, 0, len(modules))
+	for _, m := range modules {
+		info := Info{Name: m.Name(), Enabled: IsEnabled(m), Indices: []string{}}
+		for index := range m.Mappings() {
+			info.Indices = append(info.Indices, index)
+		}
+		sort.Strings(info.Indices)
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Name < infos[j].Name
+	})
+	return infos
+}
+
+// Hooks tracks the subscriptions and dispatch routes of a module so they may be removed on shutdown
+type Hooks struct {
+	mu     sync.Mutex
+	subs   []subscription
+	routes []string
+}
+
+type subscription struct {
+	topic string
+	fn    interface{}
+}
+
+// Subscribe subscribes fn to topic with an asynchronous callback
+func (h *Hooks) Subscribe(topic string, fn interface{}) {
+	h.mu.Lock()
+	h.subs = append(h.subs, subscription{topic, fn})
+	h.mu.Unlock()
+	events.SubscribeAsync(topic, fn)
+}
+
+// Dispatch registers a dispatch route
+func (h *Hooks) Dispatch(r dispatch.Route) {
+	h.mu.Lock()
+	h.routes = append(h.routes, r.Name)
+	h.mu.Unlock()
+	dispatch.Register(r)
+}
+
+// Remove unregisters every route and unsubscribes every handler
+func (h *Hooks) Remove() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, name := range h.routes {
+		dispatch.Unregister(name)
+	}
+	for _, s := range h.subs {
+		events.Unsubscribe(s.topic, s.fn)
+	}
+	h.routes = nil
+	h.subs = nil
+}
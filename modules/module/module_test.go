@@ -0,0 +1,93 @@
+package module
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spf13/viper"
+
+	"github.com/oipwg/oip/dispatch"
+)
+
+type testModule struct {
+	name string
+}
+
+func (m *testModule) Name() string                                     { return m.name }
+func (m *testModule) Mappings() map[string]string                      { return nil }
+func (m *testModule) Routes()                                          {}
+func (m *testModule) Init(ctx context.Context, cfg *viper.Viper) error { return nil }
+func (m *testModule) Shutdown(ctx context.Context) error               { return nil }
+
+type testDefaulter struct {
+	testModule
+}
+
+func (m *testDefaulter) EnabledByDefault() bool { return false }
+
+func TestIsEnabled(t *testing.T) {
+	if !IsEnabled(&testModule{name: "testA"}) {
+		t.Error("modules should be enabled by default")
+	}
+	if IsEnabled(&testDefaulter{testModule{name: "testB"}}) {
+		t.Error("testB should follow its default")
+	}
+
+	viper.Set("oip.modules.testA.enabled", false)
+	viper.Set("oip.modules.testB.enabled", true)
+	if IsEnabled(&testModule{name: "testA"}) {
+		t.Error("testA should be disabled by config")
+	}
+	if !IsEnabled(&testDefaulter{testModule{name: "testB"}}) {
+		t.Error("testB should be enabled by config")
+	}
+}
+
+func TestModuleConfig(t *testing.T) {
+	viper.SetDefault("oip.modules.testC.depth", 10)
+	viper.SetDefault("oip.modules.testC.dir", "scripts")
+	// a section in the config file replaces the defaults viper.Sub would return
+	err := viper.MergeConfigMap(map[string]interface{}{
+		"oip": map[string]interface{}{"modules": map[string]interface{}{"testC": map[string]interface{}{"enabled": true, "dir": "custom"}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := moduleConfig(&testModule{name: "testC"})
+	if d := cfg.GetInt("depth"); d != 10 {
+		t.Errorf("default depth %d, want 10", d)
+	}
+	if d := cfg.GetString("dir"); d != "custom" {
+		t.Errorf("configured dir %q, want custom", d)
+	}
+	if !cfg.GetBool("enabled") {
+		t.Error("configured enabled lost")
+	}
+}
+
+func TestHooksRemove(t *testing.T) {
+	var h Hooks
+	h.Subscribe("test:hooks", func() {})
+	h.Dispatch(dispatch.Route{
+		Name:  "test:hooks",
+		Topic: "test:hooks",
+		Match: dispatch.Prefix("test:"),
+	})
+
+	found := func() bool {
+		for _, r := range dispatch.Routes() {
+			if r.Name == "test:hooks" {
+				return true
+			}
+		}
+		return false
+	}
+	if !found() {
+		t.Fatal("route not registered")
+	}
+	h.Remove()
+	if found() {
+		t.Error("route not removed")
+	}
+}
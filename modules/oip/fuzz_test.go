@@ -0,0 +1,55 @@
+//go:build go1.18
+// +build go1.18
+
+package oip
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"testing"
+
+	"github.com/bitspill/flod/flojson"
+
+	"github.com/oipwg/oip/datastore"
+	"github.com/oipwg/oip/trace"
+)
+
+func tracedTx() *datastore.TransactionData {
+	return &datastore.TransactionData{Transaction: &flojson.TxRawResult{Txid: "txid"}, Trace: trace.New("txid")}
+}
+
+func FuzzParseMultipartSingle(f *testing.F) {
+	f.Add(mpSingle)
+	f.Fuzz(func(t *testing.T, s string) {
+		ms, _, err := parseMultipartSingle(s)
+		if err != nil {
+			return
+		}
+		if ms.Max == 0 || ms.Part > ms.Max || ms.Signature == "" {
+			t.Errorf("accepted invalid multipart %+v", ms)
+		}
+	})
+}
+
+func FuzzOnP64(f *testing.F) {
+	f.Add(floData)
+	f.Fuzz(func(t *testing.T, s string) {
+		onP64(s, tracedTx())
+	})
+}
+
+func FuzzOnGp64(f *testing.F) {
+	b, err := base64.StdEncoding.DecodeString(floData)
+	if err != nil {
+		f.Fatal(err)
+	}
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	_, _ = zw.Write(b)
+	_ = zw.Close()
+	f.Add(base64.StdEncoding.EncodeToString(buf.Bytes()))
+	f.Fuzz(func(t *testing.T, s string) {
+		onGp64(s, tracedTx())
+	})
+}
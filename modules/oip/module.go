@@ -0,0 +1,40 @@
+package oip
+
+import (
+	"context"
+
+	"github.com/spf13/viper"
+
+	"github.com/oipwg/oip/modules/module"
+)
+
+type oip struct {
+	hooks module.Hooks
+}
+
+func init() {
+	module.Register(&oip{})
+}
+
+func (o *oip) Name() string {
+	return "oip"
+}
+
+func (o *oip) Mappings() map[string]string {
+	return map[string]string{multipartIndex: "multipart.json"}
+}
+
+func (o *oip) Routes() {
+	multipartRoutes()
+}
+
+func (o *oip) Init(ctx context.Context, cfg *viper.Viper) error {
+	initOip(&o.hooks)
+	initMultipart(&o.hooks)
+	return nil
+}
+
+func (o *oip) Shutdown(ctx context.Context) error {
+	o.hooks.Remove()
+	return nil
+}
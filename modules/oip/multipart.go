@@ -20,25 +20,36 @@ import (
 	"github.com/oipwg/oip/events"
 	"github.com/oipwg/oip/flo"
 	"github.com/oipwg/oip/httpapi"
+	"github.com/oipwg/oip/metrics"
+	"github.com/oipwg/oip/modules/module"
+	"github.com/oipwg/oip/rejections"
 	oipSync "github.com/oipwg/oip/sync"
 )
 
 const multipartIndex = "oip-multipart-single"
 
 var multiPartCommitMutex sync.Mutex
-var mpRouter = httpapi.NewSubRoute("/multipart")
 
 var previousMultipartCount int
 
-func init() {
+func initMultipart(hooks *module.Hooks) {
 	log.Info("init multipart")
-	datastore.RegisterMapping(multipartIndex, "multipart.json")
-	events.SubscribeAsync("modules:oip:multipartSingle", onMultipartSingle)
-	events.SubscribeAsync("modules:oip:multipartProto", onMultipartProto)
-	events.SubscribeAsync("datastore:commit", onDatastoreCommit)
+	hooks.Subscribe("modules:oip:multipartSingle", onMultipartSingle)
+	hooks.Subscribe("modules:oip:multipartProto", onMultipartProto)
+	hooks.Subscribe("datastore:commit", onDatastoreCommit)
+}
 
-	mpRouter.HandleFunc("/get/ref/{ref:[a-f0-9]+}", handleGetRef)
-	mpRouter.HandleFunc("/get/id/{id:[a-f0-9]+}", handleGetId)
+func multipartRoutes() {
+	mpRouter := httpapi.NewSubRoute("/multipart")
+	mpRouter.HandleFunc("/get/ref/{ref:[a-f0-9]+}", handleGetRef, httpapi.RouteDoc{
+		Summary:  "Multipart pieces referencing the first part txid",
+		Paged:    true,
+		Response: httpapi.SearchResponse(nil),
+	})
+	mpRouter.HandleFunc("/get/id/{id:[a-f0-9]+}", handleGetId, httpapi.RouteDoc{
+		Summary:  "Multipart piece by txid",
+		Response: httpapi.SearchResponse(nil),
+	})
 }
 
 var (
@@ -70,14 +81,14 @@ func handleGetRef(w http.ResponseWriter, r *http.Request) {
 
 func onDatastoreCommit() {
 	// If we are still working on the initial sync, don't attempt to complete multiparts.
-	if oipSync.IsInitialSync {
+	if oipSync.IsInitialSync.IsSet() {
 		return
 	}
 
 	multiPartCommitMutex.Lock()
 	defer multiPartCommitMutex.Unlock()
 
-	wasInitialSync := oipSync.IsInitialSync
+	wasInitialSync := oipSync.IsInitialSync.IsSet()
 
 moreMultiparts:
 	multiparts := make(map[string]Multipart)
@@ -90,9 +101,9 @@ moreMultiparts:
 
 	// Check if there are no more multiparts, if so, mark the multipart sync as complete so that we can start marking Edits as invalid
 	if len(multiparts) == 0 {
-		oipSync.MultipartSyncComplete = true
+		oipSync.MultipartSyncComplete.Set(true)
 	} else {
-		oipSync.MultipartSyncComplete = false
+		oipSync.MultipartSyncComplete.Set(false)
 	}
 
 	potentialChanges := false
@@ -226,6 +237,7 @@ func onMultipartSingle(floData string, tx *datastore.TransactionData) {
 	ms, err := multipartSingleFromString(floData)
 	if err != nil {
 		log.Error("multipartSingleFromString error", logger.Attrs{"err": err, "txid": tx.Transaction.Txid})
+		rejections.Reject(tx, "multipart", rejections.StageDecode, "malformed multipart", err)
 		return
 	}
 
@@ -254,19 +266,50 @@ func onMultipartSingle(floData string, tx *datastore.TransactionData) {
 	}
 
 	bir := elastic.NewBulkIndexRequest().Index(datastore.Index(multipartIndex)).Type("_doc").Doc(ms).Id(tx.Transaction.Txid)
-	datastore.AutoBulk.Add(bir)
+	datastore.AutoBulk.AddFor(tx, bir)
+	metrics.Accepted("multipart")
 }
 
 func multipartSingleFromString(s string) (MultipartSingle, error) {
-	var ret MultipartSingle
+	ms, head, err := parseMultipartSingle(s)
+	if err != nil {
+		return ms, err
+	}
+
+	// get and check address
+	if ok, err := flo.CheckAddress(ms.Address); !ok {
+		return MultipartSingle{}, errors.Wrap(err, "ErrInvalidAddress")
+	}
+
+	// signature pre-image is <part>-<max>-<address>-<txid>-<data>
+	// in the case of multipart[0], txid is 64 zeros
+	// in the case of multipart[n], where n != 0, txid is the reference txid (from multipart[0])
+	preimage := head + "-" + ms.Reference + "-" + ms.Data
+
+	if ok, err := flo.CheckSignature(ms.Address, ms.Signature, preimage); !ok {
+		if ms.Part != 0 {
+			return MultipartSingle{}, errors.Wrap(err, "ErrBadSignature")
+		}
+		preimage := head + "-" + strings.Repeat("0", 64) + "-" + ms.Data
+		if ok, err := flo.CheckSignature(ms.Address, ms.Signature, preimage); !ok {
+			return MultipartSingle{}, errors.Wrap(err, "ErrBadSignature")
+		}
+	}
+
+	return ms, nil
+}
 
+// parseMultipartSingle splits a string multipart into its fields without verifying
+// the address or signature; head is the <part>-<max>-<address> signature pre-image
+// prefix as it appeared on chain
+func parseMultipartSingle(s string) (ret MultipartSingle, head string, err error) {
 	// trim prefix off
 	s = strings.TrimPrefix(s, "alexandria-media-multipart(")
 	s = strings.TrimPrefix(s, "oip-mp(")
 
 	comChunks := strings.Split(s, "):")
 	if len(comChunks) < 2 {
-		return ret, errors.New("malformed multi-part")
+		return ret, "", errors.New("malformed multi-part")
 	}
 
 	metaString := comChunks[0]
@@ -276,59 +319,38 @@ func multipartSingleFromString(s string) (MultipartSingle, error) {
 	lm := len(meta)
 	// 4 if omitting reference, 5 with all fields, 6 if erroneous fluffy-enigma trailing comma
 	if lm != 4 && lm != 5 && lm != 6 {
-		return ret, errors.New("malformed multi-part meta")
+		return ret, "", errors.New("malformed multi-part meta")
 	}
 
 	// check part and max
 	partS := meta[0]
-	part, err := strconv.Atoi(partS)
+	part, err := strconv.ParseUint(partS, 10, 32)
 	if err != nil {
-		return ret, errors.New("cannot convert part to int")
+		return ret, "", errors.New("cannot convert part to int")
 	}
 	maxS := meta[1]
-	max, err2 := strconv.Atoi(maxS)
-	if err2 != nil {
-		return ret, errors.New("cannot convert max to int")
+	max, err := strconv.ParseUint(maxS, 10, 32)
+	if err != nil {
+		return ret, "", errors.New("cannot convert max to int")
 	}
 
-	if max <= 0 {
-		return ret, errors.New("max must be positive")
+	if max == 0 {
+		return ret, "", errors.New("max must be positive")
 	}
 
 	if part > max {
-		return ret, errors.New("part must not exceed max")
+		return ret, "", errors.New("part must not exceed max")
 	}
 
-	// get and check address
 	address := meta[2]
-	if ok, err := flo.CheckAddress(address); !ok {
-		return ret, errors.Wrap(err, "ErrInvalidAddress")
-	}
-
 	reference := meta[3]
 	signature := meta[lm-1]
 	if signature == "" {
 		// fluffy-enigma for a while appended an erroneous trailing comma
 		signature = meta[lm-2]
 	}
-
-	// signature pre-image is <part>-<max>-<address>-<txid>-<data>
-	// in the case of multipart[0], txid is 64 zeros
-	// in the case of multipart[n], where n != 0, txid is the reference txid (from multipart[0])
-	preimage := partS + "-" + maxS + "-" + address + "-" + reference + "-" + dataString
-
-	if ok, err := flo.CheckSignature(address, signature, preimage); !ok {
-		if part != 0 {
-			return ret, errors.Wrap(err, "ErrBadSignature")
-		}
-		preimage := partS + "-" + maxS + "-" + address + "-" + strings.Repeat("0", 64) + "-" + dataString
-		if ok, err := flo.CheckSignature(address, signature, preimage); !ok {
-			return ret, errors.Wrap(err, "ErrBadSignature")
-		}
-	}
-
-	if max == 0 {
-		panic(s)
+	if signature == "" {
+		return ret, "", errors.New("missing signature")
 	}
 
 	ret = MultipartSingle{
@@ -340,7 +362,7 @@ func multipartSingleFromString(s string) (MultipartSingle, error) {
 		Data:      dataString,
 	}
 
-	return ret, nil
+	return ret, partS + "-" + maxS + "-" + address, nil
 }
 
 func markStale() {
@@ -369,11 +391,13 @@ func onMultipartProto(msg *pb_oip.SignedMessage, tx *datastore.TransactionData)
 	err := proto.Unmarshal(msg.SerializedMessage, mpp)
 	if err != nil {
 		log.Error("unable to unmarshal multipart", logger.Attrs{"txid": tx.Transaction.Txid, "err": err})
+		rejections.Reject(tx, "multipart", rejections.StageUnmarshal, "unable to unmarshal multipart", err)
 		return
 	}
 
 	if mpp.CountParts == 0 {
 		log.Error("multipart count == 0", logger.Attrs{"txid": tx.Transaction.Txid})
+		rejections.Reject(tx, "multipart", rejections.StageValidate, "multipart part count is 0", nil)
 		return
 	}
 
@@ -404,7 +428,8 @@ func onMultipartProto(msg *pb_oip.SignedMessage, tx *datastore.TransactionData)
 	}
 
 	bir := elastic.NewBulkIndexRequest().Index(datastore.Index(multipartIndex)).Type("_doc").Doc(ms).Id(tx.Transaction.Txid)
-	datastore.AutoBulk.Add(bir)
+	datastore.AutoBulk.AddFor(tx, bir)
+	metrics.Accepted("multipart")
 }
 
 type MultipartSingle struct {
@@ -4,8 +4,9 @@ import (
 	"bytes"
 	"compress/gzip"
 	"encoding/base64"
+	"errors"
+	"io"
 	"io/ioutil"
-	"strings"
 
 	"github.com/azer/logger"
 	"github.com/golang/protobuf/proto"
@@ -13,145 +14,77 @@ import (
 	"github.com/oipwg/proto/go/pb_oip"
 
 	"github.com/oipwg/oip/btc"
-	"github.com/oipwg/oip/config"
 	"github.com/oipwg/oip/datastore"
+	"github.com/oipwg/oip/dispatch"
 	"github.com/oipwg/oip/events"
 	"github.com/oipwg/oip/flo"
+	"github.com/oipwg/oip/metrics"
+	"github.com/oipwg/oip/modules/module"
+	"github.com/oipwg/oip/rejections"
 )
 
+// floData shorter than this is impossible to be a valid item
 const minFloDataLen = 35
 
-func init() {
-	log.Info("init oip")
-	if config.IsTestnet() {
-		events.SubscribeAsync("flo:floData", onFloDataTestNet)
-	} else {
-		events.SubscribeAsync("flo:floData", onFloDataMainNet)
-	}
-	events.SubscribeAsync("sync:floData:json", onJson)
-	events.SubscribeAsync("sync:floData:p64", onP64)
-	events.SubscribeAsync("sync:floData:gp64", onGp64)
-}
-
-func onFloDataMainNet(floData string, tx *datastore.TransactionData) {
-	if len(floData) < minFloDataLen {
-		// impossible to be a valid item at such a short length
-		return
-	}
-	if tx.Block < 1000000 {
-		return
-	}
-
-	simplified := strings.TrimSpace(floData[0:35])
-	simplified = strings.Replace(simplified, " ", "", -1)
-
-	if tx.Block < 2731000 && tx.Transaction.Vin[0].IsCoinBase() {
-		// oip-historian-3
-		// oip-historian-2
-		// oip-historian-1
-		// alexandria-historian-v001
-		if strings.HasPrefix(simplified, "oip-historian-") ||
-			strings.HasPrefix(simplified, "alexandria-historian-") {
-			events.Publish("modules:historian:stringDataPoint", floData, tx)
-		}
-	}
-
-	if (tx.Block > 2263000 && strings.HasPrefix(simplified, "oip-mp(")) ||
-		(tx.Block < 2400000 && strings.HasPrefix(simplified, "alexandria-media-multipart(")) {
-		events.Publish("modules:oip:multipartSingle", floData, tx)
-		return
-	}
-
-	if strings.HasPrefix(simplified, `{"alexandria-publisher":`) {
-		events.Publish("modules:oip:alexandriaPublisher", floData, tx)
-		return
-	}
-
-	if tx.Block < 2400000 {
-		if strings.HasPrefix(simplified, `{"alexandria-deactivation":`) {
-			events.Publish("modules:oip:alexandriaDeactivation", floData, tx)
-			return
-		}
-		if strings.HasPrefix(simplified, `{"alexandria-media":`) {
-			events.Publish("modules:oip:alexandriaMedia", floData, tx)
-			return
-		}
-	}
-
-	if tx.Block < 2000000 {
-		return
-	}
-
-	if strings.HasPrefix(simplified, `{"oip-041":`) {
-		events.Publish("modules:oip:oip041", floData, tx)
-		return
-	}
-
-	if processPrefix("json:", "sync:floData:json", floData, tx) {
-		return
-	}
-	if processPrefix("gp64:", "sync:floData:gp64", floData, tx) {
-		return
-	}
-	if processPrefix("p64:", "sync:floData:p64", floData, tx) {
-		return
-	}
-	if processPrefix("text:", "flo:floData", floData, tx) {
-		return
-	}
-}
-
-func onFloDataTestNet(floData string, tx *datastore.TransactionData) {
-	if len(floData) < minFloDataLen {
-		// impossible to be a valid item at such a short length
-		return
-	}
-
-	simplified := strings.TrimSpace(floData[0:35])
-	simplified = strings.Replace(simplified, " ", "", -1)
-
-	if strings.HasPrefix(simplified, "oip-mp(") ||
-		strings.HasPrefix(simplified, "alexandria-media-multipart(") {
-		events.Publish("modules:oip:multipartSingle", floData, tx)
-		return
-	}
-	if strings.HasPrefix(simplified, `{"alexandria-publisher":`) {
-		events.Publish("modules:oip:alexandriaPublisher", floData, tx)
-		return
-	}
-	if strings.HasPrefix(simplified, `{"alexandria-deactivation":`) {
-		events.Publish("modules:oip:alexandriaDeactivation", floData, tx)
-		return
-	}
-	if strings.HasPrefix(simplified, `{"alexandria-media":`) {
-		events.Publish("modules:oip:alexandriaMedia", floData, tx)
-		return
-	}
-	if strings.HasPrefix(simplified, `{"oip-041":`) {
-		events.Publish("modules:oip:oip041", floData, tx)
-		return
-	}
-	if processPrefix("json:", "sync:floData:json", floData, tx) {
-		return
-	}
-	if processPrefix("gp64:", "sync:floData:gp64", floData, tx) {
-		return
-	}
-	if processPrefix("p64:", "sync:floData:p64", floData, tx) {
-		return
-	}
-	if processPrefix("text:", "flo:floData", floData, tx) {
-		return
-	}
-}
+// floData is at most a little over a kilobyte, anything inflating beyond this is
+// not a legitimate message
+const maxDecompressedSize = 1 << 20
 
-func processPrefix(prefix, namespace, floData string, tx *datastore.TransactionData) bool {
-	if strings.HasPrefix(floData, prefix) {
-		log.Info("prefix match", logger.Attrs{"txid": tx.Transaction.Txid, "prefix": prefix, "namespace": namespace})
-		events.Publish(namespace, strings.TrimPrefix(floData, prefix), tx)
-		return true
-	}
-	return false
+func initOip(hooks *module.Hooks) {
+	log.Info("init oip")
+	hooks.Subscribe("sync:floData:json", onJson)
+	hooks.Subscribe("sync:floData:p64", onP64)
+	hooks.Subscribe("sync:floData:gp64", onGp64)
+
+	hooks.Dispatch(dispatch.Route{
+		Name:     "oip:multipart",
+		Topic:    "modules:oip:multipartSingle",
+		Match:    dispatch.CompactPrefix("oip-mp("),
+		Priority: 90,
+		Mainnet:  &dispatch.Window{Min: 2263001},
+		Testnet:  &dispatch.Window{},
+	})
+	hooks.Dispatch(dispatch.Route{
+		Name:     "oip:multipart:alexandria",
+		Topic:    "modules:oip:multipartSingle",
+		Match:    dispatch.CompactPrefix("alexandria-media-multipart("),
+		Priority: 90,
+		Mainnet:  &dispatch.Window{Min: 1000000, Max: 2400000},
+		Testnet:  &dispatch.Window{},
+	})
+	hooks.Dispatch(dispatch.Route{
+		Name:     "oip:json",
+		Topic:    "sync:floData:json",
+		Match:    dispatch.MinLength(minFloDataLen, dispatch.StripPrefix("json:")),
+		Priority: 40,
+		Mainnet:  &dispatch.Window{Min: 2000000},
+		Testnet:  &dispatch.Window{},
+	})
+	hooks.Dispatch(dispatch.Route{
+		Name:     "oip:gp64",
+		Topic:    "sync:floData:gp64",
+		Match:    dispatch.MinLength(minFloDataLen, dispatch.StripPrefix("gp64:")),
+		Priority: 30,
+		Mainnet:  &dispatch.Window{Min: 2000000},
+		Testnet:  &dispatch.Window{},
+	})
+	hooks.Dispatch(dispatch.Route{
+		Name:     "oip:p64",
+		Topic:    "sync:floData:p64",
+		Match:    dispatch.MinLength(minFloDataLen, dispatch.StripPrefix("p64:")),
+		Priority: 20,
+		Mainnet:  &dispatch.Window{Min: 2000000},
+		Testnet:  &dispatch.Window{},
+	})
+	// text: wrapped floData is dispatched again without the prefix
+	hooks.Dispatch(dispatch.Route{
+		Name:     "oip:text",
+		Topic:    "flo:floData",
+		Match:    dispatch.MinLength(minFloDataLen, dispatch.StripPrefix("text:")),
+		Priority: 10,
+		Mainnet:  &dispatch.Window{Min: 2000000},
+		Testnet:  &dispatch.Window{},
+	})
 }
 
 func onJson(floData string, tx *datastore.TransactionData) {
@@ -161,16 +94,19 @@ func onJson(floData string, tx *datastore.TransactionData) {
 	var dj map[string]jsoniter.RawMessage
 	err := jsoniter.Unmarshal([]byte(floData), &dj)
 	if err != nil {
+		rejections.Reject(tx, "oip", rejections.StageDecode, "invalid json", err)
 		return
 	}
 
 	if o42, ok := dj["oip042"]; ok {
 		log.Info("sending oip042 message", attr)
 		events.Publish("modules:oip042:json", o42, tx)
+		metrics.Accepted("oip")
 		return
 	}
 
 	log.Error("no supported json type", attr)
+	rejections.Reject(tx, "oip", rejections.StageRoute, "no supported json type", nil)
 }
 
 func onP64(p64 string, tx *datastore.TransactionData) {
@@ -183,6 +119,7 @@ func onP64(p64 string, tx *datastore.TransactionData) {
 		attr["err"] = err
 		log.Error("unable to decode base 64 message",
 			attr)
+		rejections.Reject(tx, "oip", rejections.StageDecode, "invalid base64", err)
 		return
 	}
 
@@ -196,6 +133,7 @@ func onGp64(gp64 string, tx *datastore.TransactionData) {
 	if err != nil {
 		attr["err"] = err
 		log.Error("unable to decode base 64 message", attr)
+		rejections.Reject(tx, "oip", rejections.StageDecode, "invalid base64", err)
 		return
 	}
 
@@ -204,13 +142,18 @@ func onGp64(gp64 string, tx *datastore.TransactionData) {
 	if err != nil {
 		attr["err"] = err
 		log.Error("unable to initialize decompressor", attr)
+		rejections.Reject(tx, "oip", rejections.StageDecode, "unable to initialize decompressor", err)
 		return
 	}
 
-	pb, err := ioutil.ReadAll(gr)
+	pb, err := ioutil.ReadAll(io.LimitReader(gr, maxDecompressedSize+1))
+	if err == nil && len(pb) > maxDecompressedSize {
+		err = errors.New("decompressed data exceeds limit")
+	}
 	if err != nil {
 		attr["err"] = err
 		log.Error("unable to decompress data", attr)
+		rejections.Reject(tx, "oip", rejections.StageDecode, "unable to decompress", err)
 		return
 	}
 
@@ -224,6 +167,7 @@ func processProto(b []byte, tx *datastore.TransactionData, attr logger.Attrs) {
 		attr["err"] = err
 		log.Error("unable to unmarshal protobuf message",
 			attr)
+		rejections.Reject(tx, "oip", rejections.StageUnmarshal, "unable to unmarshal signed message", err)
 		return
 	}
 
@@ -241,6 +185,7 @@ func processProto(b []byte, tx *datastore.TransactionData, attr logger.Attrs) {
 			attr["sigType"] = msg.SignatureType
 			attr["signature"] = signature
 			log.Error("btc signature validation failed", attr)
+			rejections.Reject(tx, "oip", rejections.StageSignature, "invalid btc signature", err)
 			return
 		}
 	case pb_oip.SignatureTypes_Flo:
@@ -252,11 +197,13 @@ func processProto(b []byte, tx *datastore.TransactionData, attr logger.Attrs) {
 			attr["sigType"] = msg.SignatureType
 			attr["signature"] = signature
 			log.Error("flo signature validation failed", attr)
+			rejections.Reject(tx, "oip", rejections.StageSignature, "invalid flo signature", err)
 			return
 		}
 	default:
 		attr["sigType"] = msg.SignatureType
 		log.Error("unsupported proto signature type", attr)
+		rejections.Reject(tx, "oip", rejections.StageSignature, "unsupported signature type", nil)
 		return
 	}
 
@@ -271,5 +218,8 @@ func processProto(b []byte, tx *datastore.TransactionData, attr logger.Attrs) {
 		attr["err"] = err
 		attr["msgType"] = msg.MessageType
 		log.Error("unsupported proto message type", attr)
+		rejections.Reject(tx, "oip", rejections.StageRoute, "unsupported message type", nil)
+		return
 	}
+	metrics.Accepted("oip")
 }
@@ -16,3 +16,32 @@ func TestOnP64(t *testing.T) {
 	onP64(floData, &datastore.TransactionData{Transaction: &flojson.TxRawResult{}})
 	// ToDo: validate results, only useful in debugger for now
 }
+
+const mpSingle = "oip-mp(1,3,FLmic78oU6eqXsTAaHGGdrFyY7FkzPfRTA,4a059effa20389f2be9bfad9308f4a46b4c2bfaf02dd65e68f113db1669fba81,H2sT2X1bzS0p4N0/0ny9/8j5aSAdV4oG9j5M2d7DQd8o=):{\"oip042\":{\"publish\":"
+
+func TestParseMultipartSingle(t *testing.T) {
+	ms, head, err := parseMultipartSingle(mpSingle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ms.Part != 1 || ms.Max != 3 || ms.Data != `{"oip042":{"publish":` {
+		t.Errorf("unexpected multipart %+v", ms)
+	}
+	if head != "1-3-FLmic78oU6eqXsTAaHGGdrFyY7FkzPfRTA" {
+		t.Errorf("unexpected pre-image head %q", head)
+	}
+
+	for _, s := range []string{
+		"oip-mp(",
+		"oip-mp(1,3,addr,sig)",
+		"oip-mp(-1,3,addr,ref,sig):data",
+		"oip-mp(1,0,addr,ref,sig):data",
+		"oip-mp(4,3,addr,ref,sig):data",
+		"oip-mp(1,4294967296,addr,ref,sig):data",
+		"oip-mp(1,3,addr,ref,,):data",
+	} {
+		if _, _, err := parseMultipartSingle(s); err == nil {
+			t.Errorf("expected error for %q", s)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package oip041
 
 import (
+	"context"
 	"net/http"
 	"strconv"
 
@@ -8,28 +9,72 @@ import (
 	"github.com/gorilla/mux"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/pkg/errors"
+	"github.com/spf13/viper"
 	"gopkg.in/olivere/elastic.v6"
 
 	"github.com/oipwg/oip/datastore"
-	"github.com/oipwg/oip/events"
+	"github.com/oipwg/oip/dispatch"
 	"github.com/oipwg/oip/filters"
 	"github.com/oipwg/oip/flo"
 	"github.com/oipwg/oip/httpapi"
+	"github.com/oipwg/oip/metrics"
+	"github.com/oipwg/oip/modules/module"
+	"github.com/oipwg/oip/rejections"
 )
 
 const oip41IndexName = "oip041"
 
-var artRouter = httpapi.NewSubRoute("/oip041/artifact")
+type oip041 struct {
+	hooks module.Hooks
+}
 
 func init() {
+	module.Register(&oip041{})
+}
+
+func (o *oip041) Name() string {
+	return "oip041"
+}
+
+func (o *oip041) Mappings() map[string]string {
+	return map[string]string{oip41IndexName: "oip041.json"}
+}
+
+func (o *oip041) Init(ctx context.Context, cfg *viper.Viper) error {
 	log.Info("init oip41")
-	events.SubscribeAsync("modules:oip:oip041", on41)
+	o.hooks.Subscribe("modules:oip:oip041", on41)
+	o.hooks.Dispatch(dispatch.Route{
+		Name:     "oip041",
+		Topic:    "modules:oip:oip041",
+		Match:    dispatch.CompactPrefix(`{"oip-041":`),
+		Priority: 50,
+		Mainnet:  &dispatch.Window{Min: 2000000},
+		Testnet:  &dispatch.Window{},
+	})
+	return nil
+}
 
-	datastore.RegisterMapping(oip41IndexName, "oip041.json")
+func (o *oip041) Shutdown(ctx context.Context) error {
+	o.hooks.Remove()
+	return nil
+}
 
-	artRouter.HandleFunc("/get/latest", handleLatest).Queries("nsfw", "{nsfw}")
-	artRouter.HandleFunc("/get/latest", handleLatest)
-	artRouter.HandleFunc("/get/{id:[a-f0-9]+}", handleGet)
+func (o *oip041) Routes() {
+	artRouter := httpapi.NewSubRoute("/oip041/artifact")
+	artRouter.HandleFunc("/get/latest", handleLatest, httpapi.RouteDoc{
+		Summary:  "Latest oip041 artifacts, optionally filtered by nsfw",
+		Paged:    true,
+		Response: httpapi.SearchResponse(nil),
+	}).Queries("nsfw", "{nsfw}")
+	artRouter.HandleFunc("/get/latest", handleLatest, httpapi.RouteDoc{
+		Summary:  "Latest oip041 artifacts",
+		Paged:    true,
+		Response: httpapi.SearchResponse(nil),
+	})
+	artRouter.HandleFunc("/get/{id:[a-f0-9]+}", handleGet, httpapi.RouteDoc{
+		Summary:  "oip041 artifacts by txid or txid prefix",
+		Response: httpapi.SearchResponse(nil),
+	})
 }
 
 var (
@@ -78,11 +123,13 @@ func on41(floData string, tx *datastore.TransactionData) {
 	el, err := validateOip041(any, tx)
 	if err != nil {
 		log.Error("validate oip041 failed", logger.Attrs{"err": err})
+		rejections.Reject(tx, "oip041", rejections.StageValidate, "invalid oip041 artifact", err)
 		return
 	}
 
 	bir := elastic.NewBulkIndexRequest().Index(datastore.Index("oip041")).Type("_doc").Id(tx.Transaction.Txid).Doc(el)
-	datastore.AutoBulk.Add(bir)
+	datastore.AutoBulk.AddFor(tx, bir)
+	metrics.Accepted("oip041")
 }
 
 type elasticOip041 struct {
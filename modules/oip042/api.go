@@ -11,22 +11,68 @@ import (
 	"github.com/oipwg/oip/httpapi"
 )
 
-var artRouter = httpapi.NewSubRoute("/oip042/artifact")
-var recordRouter = httpapi.NewSubRoute("/oip042/record")
-var editRouter = httpapi.NewSubRoute("/oip042/edit")
-
-func init() {
-	artRouter.HandleFunc("/get/latest", handleLatest).Queries("nsfw", "{nsfw}")
-	artRouter.HandleFunc("/get/latest", handleLatest)
-	recordRouter.HandleFunc("/get/{originalTxid}", handleGetLatestEdit)
-	recordRouter.HandleFunc("/get/{originalTxid}/version/{editRecordTxid}", handleGetForVersion)
-	editRouter.HandleFunc("/get/{editRecordTxid}", handleGetEditRecord)
-	editRouter.HandleFunc("/search", handleEditSearch).Queries("q", "{query}")
+func registerRoutes() {
+	artRouter := httpapi.NewSubRoute("/oip042/artifact")
+	recordRouter := httpapi.NewSubRoute("/oip042/record")
+	editRouter := httpapi.NewSubRoute("/oip042/edit")
+
+	artRouter.HandleFunc("/get/latest", handleLatest, httpapi.RouteDoc{
+		Summary:  "Latest oip042 artifacts, optionally filtered by nsfw",
+		Paged:    true,
+		Response: httpapi.SearchResponse(nil),
+	}).Queries("nsfw", "{nsfw}")
+	artRouter.HandleFunc("/get/latest", handleLatest, httpapi.RouteDoc{
+		Summary:  "Latest oip042 artifacts",
+		Paged:    true,
+		Response: httpapi.SearchResponse(nil),
+	})
+	recordRouter.HandleFunc("/get/{originalTxid}", handleGetLatestEdit, httpapi.RouteDoc{
+		Summary:  "Latest version of an oip042 artifact, with all edits applied",
+		Response: httpapi.SearchResponse(nil),
+	})
+	recordRouter.HandleFunc("/get/{originalTxid}/version/{editRecordTxid}", handleGetForVersion, httpapi.RouteDoc{
+		Summary:  "Version of an oip042 artifact as of the provided edit",
+		Response: httpapi.SearchResponse(nil),
+		Cache:    httpapi.CachePinned,
+	})
+	editRouter.HandleFunc("/get/{editRecordTxid}", handleGetEditRecord, httpapi.RouteDoc{
+		Summary:  "oip042 edit by txid",
+		Response: httpapi.SearchResponse(nil),
+	})
+	editRouter.HandleFunc("/search", handleEditSearch, httpapi.RouteDoc{
+		Summary:  "Search oip042 edits with an Elasticsearch query string",
+		Paged:    true,
+		Response: httpapi.SearchResponse(nil),
+	}).Queries("q", "{query}")
+	editRouter.HandleFunc("/search", o42EditSearch.HandleSearch,
+		o42EditSearch.SearchDoc("Search oip042 edits with a json search query")).Methods("POST")
+	editRouter.HandleFunc("/facets", o42EditSearch.HandleFacets,
+		o42EditSearch.FacetsDoc("Facets over oip042 edits")).Methods("POST")
+	editRouter.HandleFunc("/export", o42EditSearch.HandleExport,
+		o42EditSearch.ExportDoc("Export all oip042 edits matching a json search query")).Methods("GET", "POST")
 }
 
 var (
 	o42ArtifactFsc = elastic.NewFetchSourceContext(true).Include("artifact.*", "meta.block_hash", "meta.txid", "meta.block", "meta.time", "meta.originalTxid", "meta.type")
 	o42EditFsc     = elastic.NewFetchSourceContext(true).Include("edit.*", "meta.block_hash", "meta.txid", "meta.block", "meta.time", "meta.originalTxid", "meta.type", "meta.completed")
+	o42EditSearch  = &httpapi.SearchResource{
+		Indices: []string{oip042EditIndex},
+		Fields: httpapi.SearchFields{
+			"edit.timestamp":            httpapi.DateField,
+			"edit.txid.keyword":         httpapi.KeywordField,
+			"meta.block":                httpapi.NumericField,
+			"meta.time":                 httpapi.DateField,
+			"meta.completed":            httpapi.BoolField,
+			"meta.invalid":              httpapi.BoolField,
+			"meta.txid.keyword":         httpapi.KeywordField,
+			"meta.originalTxid.keyword": httpapi.KeywordField,
+			"meta.type.keyword":         httpapi.KeywordField,
+		},
+		Sorts: []elastic.SortInfo{
+			{Field: "meta.time", Ascending: false},
+		},
+		Fsc: o42EditFsc,
+	}
 )
 
 func handleLatest(w http.ResponseWriter, r *http.Request) {
@@ -2,6 +2,7 @@ package oip042
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -12,7 +13,9 @@ import (
 	"github.com/oipwg/oip/datastore"
 	"github.com/oipwg/oip/filters"
 	"github.com/oipwg/oip/flo"
+	"github.com/oipwg/oip/metrics"
 	"github.com/oipwg/oip/modules/oip042/validators"
+	"github.com/oipwg/oip/rejections"
 )
 
 func on42JsonPublishArtifact(artifact jsoniter.Any, tx *datastore.TransactionData) {
@@ -21,6 +24,7 @@ func on42JsonPublishArtifact(artifact jsoniter.Any, tx *datastore.TransactionDat
 	title := artifact.Get("info", "title").ToString()
 	if len(title) == 0 {
 		log.Error("oip042 no title", attr)
+		rejections.Reject(tx, "oip042", rejections.StageValidate, "missing info.title", nil)
 		return
 	}
 
@@ -29,6 +33,7 @@ func on42JsonPublishArtifact(artifact jsoniter.Any, tx *datastore.TransactionDat
 	if !ok {
 		attr["err"] = err
 		log.Error("invalid FLO address", attr)
+		rejections.Reject(tx, "oip042", rejections.StageValidate, "invalid floAddress", err)
 		return
 	}
 
@@ -44,6 +49,7 @@ func on42JsonPublishArtifact(artifact jsoniter.Any, tx *datastore.TransactionDat
 		attr["address"] = floAddr
 		attr["sig"] = sig
 		log.Error("invalid signature", attr)
+		rejections.Reject(tx, "oip042", rejections.StageSignature, "invalid signature", err)
 		return
 	}
 
@@ -54,6 +60,7 @@ func on42JsonPublishArtifact(artifact jsoniter.Any, tx *datastore.TransactionDat
 		attr["type"] = t
 		attr["subtype"] = st
 		log.Error("artifact validation failed", attr)
+		rejections.Reject(tx, "oip042", rejections.StageValidate, "artifact validation failed", fmt.Errorf("type %q subType %q", t, st))
 		return
 	}
 
@@ -78,7 +85,8 @@ func on42JsonPublishArtifact(artifact jsoniter.Any, tx *datastore.TransactionDat
 
 	// Send off a bulk index request :)
 	bir := elastic.NewBulkIndexRequest().Index(datastore.Index(oip042ArtifactIndex)).Type("_doc").Id(tx.Transaction.Txid).Doc(el)
-	datastore.AutoBulk.Add(bir)
+	datastore.AutoBulk.AddFor(tx, bir)
+	metrics.Accepted("oip042")
 
 	// Check to see if we should process the store
 	_, err = datastore.AutoBulk.CheckSizeStore(context.TODO())
@@ -110,7 +118,8 @@ func on42JsonEditArtifact(any jsoniter.Any, tx *datastore.TransactionData, sig s
 
 	el.Patch = any.Get("patch").ToString()
 	bir := elastic.NewBulkIndexRequest().Index(datastore.Index(oip042EditIndex)).Type("_doc").Id(tx.Transaction.Txid).Doc(el)
-	datastore.AutoBulk.Add(bir)
+	datastore.AutoBulk.AddFor(tx, bir)
+	metrics.Accepted("oip042")
 }
 
 func on42JsonTransferArtifact(any jsoniter.Any, tx *datastore.TransactionData) {
@@ -133,7 +142,8 @@ func on42JsonTransferArtifact(any jsoniter.Any, tx *datastore.TransactionData) {
 	}
 
 	bir := elastic.NewBulkIndexRequest().Index(datastore.Index(oip042TransferIndex)).Type("_doc").Id(tx.Transaction.Txid).Doc(el)
-	datastore.AutoBulk.Add(bir)
+	datastore.AutoBulk.AddFor(tx, bir)
+	metrics.Accepted("oip042")
 }
 
 func on42JsonDeactivateArtifact(any jsoniter.Any, tx *datastore.TransactionData) {
@@ -156,5 +166,6 @@ func on42JsonDeactivateArtifact(any jsoniter.Any, tx *datastore.TransactionData)
 	}
 
 	bir := elastic.NewBulkIndexRequest().Index(datastore.Index(oip042DeactivateIndex)).Type("_doc").Id(tx.Transaction.Txid).Doc(el)
-	datastore.AutoBulk.Add(bir)
+	datastore.AutoBulk.AddFor(tx, bir)
+	metrics.Accepted("oip042")
 }
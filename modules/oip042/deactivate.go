@@ -3,23 +3,27 @@ package oip042
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"strconv"
 	"sync"
 
 	"github.com/azer/logger"
+	"github.com/json-iterator/go"
 	"gopkg.in/olivere/elastic.v6"
 
 	"github.com/oipwg/oip/datastore"
-	"github.com/oipwg/oip/events"
+	"github.com/oipwg/oip/flo"
+	"github.com/oipwg/oip/modules/module"
 )
 
 var deactivationCommitMutex sync.Mutex
 
-func init() {
-	events.SubscribeAsync("modules:oip:mpCompleted", onMpCompleted)
+func initDeactivate(hooks *module.Hooks) {
+	hooks.Subscribe("modules:oip:mpCompleted", onMpCompleted)
 }
 
 func onMpCompleted() {
-	exist, err := datastore.Client().IndexExists(oip042DeactivateIndex).Do(context.TODO())
+	exist, err := datastore.Client().IndexExists(datastore.Index(oip042DeactivateIndex)).Do(context.TODO())
 	if err != nil {
 		log.Error("elastic index exists failed", logger.Attrs{"err": err, "index": oip042DeactivateIndex})
 		return
@@ -32,8 +36,8 @@ func onMpCompleted() {
 	defer deactivationCommitMutex.Unlock()
 
 	q := elastic.NewBoolQuery().Must(
-		elastic.NewTermQuery("meta.complete", false),
-		elastic.NewTermQuery("meta.stale", false),
+		elastic.NewTermQuery("meta.completed", false),
+		elastic.NewTermQuery("meta.invalid", false),
 	)
 	results, err := datastore.Client().Search(datastore.Index(oip042DeactivateIndex)).Type("_doc").Query(q).Size(10000).Sort("meta.time", false).Do(context.TODO())
 	if err != nil {
@@ -56,18 +60,52 @@ func onMpCompleted() {
 			continue
 		}
 
+		ok, err := deactivationSigned(ea)
+		if err != nil {
+			log.Error("unable to check deactivation", logger.Attrs{"err": err, "reference": ea.Deactivate.Reference, "txid": ea.Meta.Txid})
+			continue
+		}
+		if !ok {
+			log.Error("deactivation not signed by artifact publisher", logger.Attrs{"reference": ea.Deactivate.Reference, "txid": ea.Meta.Txid})
+			s := elastic.NewScript("ctx._source.meta.invalid=true;").Type("inline").Lang("painless")
+			up := elastic.NewBulkUpdateRequest().Index(datastore.Index(oip042DeactivateIndex)).Id(ea.Meta.Txid).Type("_doc").Script(s)
+			datastore.AutoBulk.Add(up)
+			continue
+		}
+
 		// deactivate the artifact
 		s := elastic.NewScript("ctx._source.meta.deactivated=true;").Type("inline").Lang("painless")
 		up := elastic.NewBulkUpdateRequest().Index(datastore.Index(oip042ArtifactIndex)).Id(ea.Deactivate.Reference).Type("_doc").Script(s)
 		datastore.AutoBulk.Add(up)
 
 		// tag deactivation as completed
-		s = elastic.NewScript("ctx._source.meta.complete=true;").Type("inline").Lang("painless")
+		s = elastic.NewScript("ctx._source.meta.completed=true;").Type("inline").Lang("painless")
 		up = elastic.NewBulkUpdateRequest().Index(datastore.Index(oip042DeactivateIndex)).Id(ea.Meta.Txid).Type("_doc").Script(s)
 		datastore.AutoBulk.Add(up)
 	}
 }
 
+// deactivationSigned checks the signature of a deactivation against the
+// floAddress of the artifact it references, over reference-timestamp as edits
+// are signed; an artifact not yet indexed returns an error leaving it pending
+func deactivationSigned(ea elasticOip042Deactivate) (bool, error) {
+	get, err := datastore.Client().Get().Index(datastore.Index(oip042ArtifactIndex)).Type("_doc").Id(ea.Deactivate.Reference).Do(context.TODO())
+	if err != nil {
+		if elastic.IsNotFound(err) {
+			return false, errors.New("artifact not found")
+		}
+		return false, err
+	}
+	if !get.Found || get.Source == nil {
+		return false, errors.New("artifact not found")
+	}
+
+	floAddress := jsoniter.Get(*get.Source, "artifact", "floAddress").ToString()
+	preImage := ea.Deactivate.Reference + "-" + strconv.FormatInt(ea.Deactivate.Timestamp, 10)
+	ok, _ := flo.CheckSignature(floAddress, ea.Meta.Signature, preImage)
+	return ok, nil
+}
+
 type elasticOip042DeactivateInterface struct {
 	Deactivate interface{} `json:"deactivate"`
 	Meta       OMeta       `json:"meta"`
@@ -76,6 +114,7 @@ type elasticOip042DeactivateInterface struct {
 type elasticOip042Deactivate struct {
 	Deactivate struct {
 		Reference string `json:"reference"`
+		Timestamp int64  `json:"timestamp"`
 	} `json:"deactivate"`
 	Meta OMeta `json:"meta"`
 }
@@ -0,0 +1,71 @@
+package oip042
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/oipwg/oip/datastore"
+)
+
+func TestOnMpCompletedDeactivates(t *testing.T) {
+	s := useDatastore()
+	defer s.Close()
+
+	owner := newSigner(t)
+	other := newSigner(t)
+	const timestamp = 1525138300
+
+	cases := []struct {
+		txid            string
+		signer          signer
+		completed       bool
+		invalid         bool
+		wantDeactivated bool
+		wantCompleted   bool
+		wantInvalid     bool
+	}{
+		{"pending", owner, false, false, true, true, false},
+		{"completed", owner, true, false, false, true, false},
+		{"invalid", owner, false, true, false, false, true},
+		{"foreign", other, false, false, false, false, true},
+	}
+	for _, c := range cases {
+		s.Put(datastore.Index(oip042ArtifactIndex), c.txid, map[string]interface{}{
+			"artifact": map[string]interface{}{"floAddress": owner.address},
+			"meta":     map[string]interface{}{"deactivated": false, "txid": c.txid},
+		})
+		s.Put(datastore.Index(oip042DeactivateIndex), "deactivate-"+c.txid, map[string]interface{}{
+			"deactivate": map[string]interface{}{"reference": c.txid, "timestamp": timestamp},
+			"meta": map[string]interface{}{
+				"completed": c.completed,
+				"invalid":   c.invalid,
+				"signature": c.signer.sign(t, c.txid+"-"+strconv.Itoa(timestamp)),
+				"txid":      "deactivate-" + c.txid,
+				"type":      "artifact",
+			},
+		})
+	}
+	// the artifact of a deactivation may not be indexed yet
+	s.Put(datastore.Index(oip042DeactivateIndex), "deactivate-missing", map[string]interface{}{
+		"deactivate": map[string]interface{}{"reference": "missing", "timestamp": timestamp},
+		"meta":       map[string]interface{}{"completed": false, "invalid": false, "txid": "deactivate-missing", "type": "artifact"},
+	})
+
+	onMpCompleted()
+	datastore.AutoBulk.Commit()
+
+	for _, c := range cases {
+		t.Run(c.txid, func(t *testing.T) {
+			if d := meta(getDoc(t, s, oip042ArtifactIndex, c.txid), "deactivated"); d != c.wantDeactivated {
+				t.Errorf("artifact deactivated %v, want %v", d, c.wantDeactivated)
+			}
+			d := getDoc(t, s, oip042DeactivateIndex, "deactivate-"+c.txid)
+			if meta(d, "completed") != c.wantCompleted || meta(d, "invalid") != c.wantInvalid {
+				t.Errorf("deactivation completed %v invalid %v, want %v %v", meta(d, "completed"), meta(d, "invalid"), c.wantCompleted, c.wantInvalid)
+			}
+		})
+	}
+	if d := getDoc(t, s, oip042DeactivateIndex, "deactivate-missing"); meta(d, "completed") != false || meta(d, "invalid") != false {
+		t.Errorf("deactivation of a missing artifact completed %v invalid %v", meta(d, "completed"), meta(d, "invalid"))
+	}
+}
@@ -15,24 +15,24 @@ import (
 	"gopkg.in/olivere/elastic.v6"
 
 	"github.com/oipwg/oip/datastore"
-	"github.com/oipwg/oip/events"
 	"github.com/oipwg/oip/flo"
+	"github.com/oipwg/oip/modules/module"
 	oipSync "github.com/oipwg/oip/sync"
 )
 
 var editCommitMutex sync.Mutex
 var previousEditLength int
 
-func init() {
+func initEdit(hooks *module.Hooks) {
 	log.Info("init edit")
 	// Subscribe to the datastore event emitter, run our edit processing on each datastore
-	events.SubscribeAsync("datastore:commit", onDatastoreCommit)
+	hooks.Subscribe("datastore:commit", onDatastoreCommit)
 }
 
 func onDatastoreCommit() {
 	// If we are still working on the initial sync and completing multiparts, 
 	// don't attempt to apply edits yet.
-	if !oipSync.MultipartSyncComplete {
+	if !oipSync.MultipartSyncComplete.IsSet() {
 		return
 	}
 
@@ -50,7 +50,7 @@ moreEdits:
 
 	// Check if there are edits that need to be completed
 	if len(edits) > 0 {
-		oipSync.EditSyncComplete = false
+		oipSync.EditSyncComplete.Set(false)
 		// Make sure that we are only processing a single Edit for each OriginalTXID
 		editMap := make(map[string]bool)
 		filteredEdits := []*elasticOip042Edit{}
@@ -78,7 +78,7 @@ moreEdits:
 
 				// Check if we should mark this edit as invalid (if all multiparts are complete, and we still can't find the Record, then the Edit
 				// txid is likely invalid and the Edit should be marked as invalid)
-				if oipSync.MultipartSyncComplete {
+				if oipSync.MultipartSyncComplete.IsSet() {
 					err = markEditInvalid(editRecord)
 					if err != nil {
 						log.Error("Error while marking Edit (%v) as invalid! Error: %v", editRecord.Meta.Txid, err)
@@ -110,7 +110,7 @@ moreEdits:
 			goto moreEdits
 		}
 	} else {
-		oipSync.EditSyncComplete = true
+		oipSync.EditSyncComplete.Set(true)
 	}
 }
 
@@ -253,23 +253,11 @@ func processRecord(editRecord *elasticOip042Edit, artifactRecord *elasticOip042A
 	}
 
 	// Attempt to decode the patch
-	editPatch, err := jsonpatch.DecodePatch([]byte(editRecord.Patch))
+	editPatch, err := artifactPatch(editRecord.Patch)
 	if err != nil {
 		return fmt.Errorf("Could not decode Edit patch! %v", err)
 	}
 
-	// Prepend on "artifact" at the start of the edit patch to decend to the correct level
-	for i, operation := range editPatch {
-		quotedPath := *operation["path"]
-		pathPrefix := []byte("\"/artifact")
-
-		newPath := make([]byte, len(pathPrefix)+len(quotedPath)-1)
-		copy(newPath, pathPrefix)
-		copy(newPath[len(pathPrefix):], quotedPath[1:])
-
-		editPatch[i]["path"] = (*json.RawMessage)(&newPath)
-	}
-
 	// Apply the patch to the serialized Record
 	jsonModifiedArtRecord, err := editPatch.Apply(jsonArtRecord)
 	if err != nil {
@@ -326,3 +314,31 @@ func processRecord(editRecord *elasticOip042Edit, artifactRecord *elasticOip042A
 	// Return nil if everything was successful
 	return nil
 }
+
+// artifactPatch decodes an edit patch and prepends "/artifact" to the path of
+// each operation to descend to the correct level of the stored record
+func artifactPatch(patch string) (jsonpatch.Patch, error) {
+	editPatch, err := jsonpatch.DecodePatch([]byte(patch))
+	if err != nil {
+		return nil, err
+	}
+
+	for i, operation := range editPatch {
+		rawPath, ok := operation["path"]
+		if !ok || rawPath == nil {
+			return nil, fmt.Errorf("operation %d is missing a path", i)
+		}
+		var path string
+		err := json.Unmarshal(*rawPath, &path)
+		if err != nil {
+			return nil, fmt.Errorf("operation %d has an invalid path: %v", i, err)
+		}
+		newPath, err := json.Marshal("/artifact" + path)
+		if err != nil {
+			return nil, err
+		}
+		editPatch[i]["path"] = (*json.RawMessage)(&newPath)
+	}
+
+	return editPatch, nil
+}
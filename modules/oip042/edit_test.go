@@ -0,0 +1,139 @@
+package oip042
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/bitspill/flod/chaincfg"
+	"github.com/bitspill/flod/floec"
+	"github.com/bitspill/flosig"
+	"github.com/bitspill/floutil"
+
+	"github.com/oipwg/oip/datastore"
+	"github.com/oipwg/oip/datastore/datastoretest"
+	oipSync "github.com/oipwg/oip/sync"
+)
+
+type signer struct {
+	pk      *floec.PrivateKey
+	address string
+}
+
+func newSigner(t *testing.T) signer {
+	pk, err := floec.NewPrivateKey(floec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, err := floutil.NewAddressPubKeyHash(floutil.Hash160(pk.PubKey().SerializeCompressed()), &chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signer{pk: pk, address: addr.EncodeAddress()}
+}
+
+func (s signer) sign(t *testing.T, message string) string {
+	sig, err := flosig.SignMessagePk(message, "Florincoin", s.pk, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sig
+}
+
+func useDatastore() *datastoretest.Server {
+	s := datastoretest.NewServer()
+	datastore.SetClient(s.Client())
+	return s
+}
+
+func getDoc(t *testing.T, s *datastoretest.Server, index, id string) map[string]interface{} {
+	t.Helper()
+	b, ok := s.Get(datastore.Index(index), id)
+	if !ok {
+		return nil
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		t.Fatal(err)
+	}
+	return doc
+}
+
+func meta(doc map[string]interface{}, field string) interface{} {
+	return doc["meta"].(map[string]interface{})[field]
+}
+
+func TestOnDatastoreCommitEdits(t *testing.T) {
+	defer oipSync.MultipartSyncComplete.Set(oipSync.MultipartSyncComplete.IsSet())
+	oipSync.MultipartSyncComplete.Set(true)
+
+	owner := newSigner(t)
+	other := newSigner(t)
+	const location = "QmNmVHfXuh5Tub3sEHBVFK6QHWsBXqgQ2mePgdZPYKCCnS"
+	const timestamp = 1525138120
+	const editTimestamp = 1525138200
+
+	cases := []struct {
+		name     string
+		original string
+		signer   signer
+		patch    string
+		applied  bool
+	}{
+		{"applied", "orig", owner, `[{"op":"replace","path":"/info/title","value":"new title"}]`, true},
+		{"signed by another address", "orig", other, `[{"op":"replace","path":"/info/title","value":"new title"}]`, false},
+		{"unknown record", "unknown", owner, `[{"op":"replace","path":"/info/title","value":"new title"}]`, false},
+		{"invalidates record signature", "orig", owner, `[{"op":"replace","path":"/storage/location","value":"QmOther"}]`, false},
+		{"malformed patch", "orig", owner, `{"op":"replace"}`, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := useDatastore()
+			defer s.Close()
+
+			s.Put(datastore.Index(oip042ArtifactIndex), "orig", map[string]interface{}{
+				"artifact": map[string]interface{}{
+					"floAddress": owner.address,
+					"timestamp":  timestamp,
+					"info":       map[string]interface{}{"title": "old title"},
+					"storage":    map[string]interface{}{"network": "IPFS", "location": location},
+					"signature":  owner.sign(t, location+"-"+owner.address+"-"+strconv.Itoa(timestamp)),
+				},
+				"meta": map[string]interface{}{"latest": true, "originalTxid": "orig", "txid": "orig", "time": 100},
+			})
+			s.Put(datastore.Index(oip042EditIndex), "edit", map[string]interface{}{
+				"edit": map[string]interface{}{"txid": c.original, "timestamp": editTimestamp},
+				"meta": map[string]interface{}{
+					"completed":    false,
+					"invalid":      false,
+					"originalTxid": c.original,
+					"txid":         "edit",
+					"time":         200,
+					"signature":    c.signer.sign(t, c.original+"-"+strconv.Itoa(editTimestamp)),
+				},
+				"patch": c.patch,
+			})
+
+			onDatastoreCommit()
+
+			edit := getDoc(t, s, oip042EditIndex, "edit")
+			if meta(edit, "completed") != c.applied || meta(edit, "invalid") == c.applied {
+				t.Errorf("edit completed %v invalid %v", meta(edit, "completed"), meta(edit, "invalid"))
+			}
+			orig := getDoc(t, s, oip042ArtifactIndex, "orig")
+			if meta(orig, "latest") == c.applied {
+				t.Errorf("original record latest %v", meta(orig, "latest"))
+			}
+			edited := getDoc(t, s, oip042ArtifactIndex, "edit")
+			if (edited != nil) != c.applied {
+				t.Fatalf("edited record stored %v, want %v", edited != nil, c.applied)
+			}
+			if c.applied {
+				title := edited["artifact"].(map[string]interface{})["info"].(map[string]interface{})["title"]
+				if title != "new title" || meta(edited, "latest") != true || meta(edited, "originalTxid") != "orig" {
+					t.Errorf("unexpected edited record %v", edited)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+//go:build go1.18
+// +build go1.18
+
+package oip042
+
+import (
+	"testing"
+
+	"github.com/bitspill/flod/flojson"
+
+	"github.com/oipwg/oip/datastore"
+	"github.com/oipwg/oip/trace"
+)
+
+const publishArtifact = `{"publish":{"artifact":{"floAddress":"FTSTq8xx8yWUKJA5E3bgXLzZqqG9V6dvnr","timestamp":1525138120,"type":"research","subtype":"tomogram","info":{"title":"the title"},"storage":{"network":"IPFS","location":"QmNmVHfXuh5Tub3sEHBVFK6QHWsBXqgQ2mePgdZPYKCCnS"},"signature":"phonysignature"}}}`
+
+func FuzzArtifactPatch(f *testing.F) {
+	f.Add(`[{"op":"replace","path":"/info/title","value":"new title"}]`)
+	f.Fuzz(func(t *testing.T, s string) {
+		_, _ = artifactPatch(s)
+	})
+}
+
+func FuzzOn42Json(f *testing.F) {
+	datastore.AutoBulk = datastore.BeginBulkIndexer()
+	f.Add([]byte(publishArtifact))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		tx := &datastore.TransactionData{
+			Transaction: &flojson.TxRawResult{Txid: "txid"},
+			Block:       2000000,
+			Trace:       trace.New("txid"),
+		}
+		on42Json(b, tx)
+	})
+}
@@ -5,7 +5,9 @@ import (
 	"github.com/json-iterator/go"
 
 	"github.com/oipwg/oip/datastore"
-	"github.com/oipwg/oip/events"
+	"github.com/oipwg/oip/httpapi"
+	"github.com/oipwg/oip/modules/module"
+	"github.com/oipwg/oip/rejections"
 )
 
 const oip042ArtifactIndex = `oip042_artifact`
@@ -18,13 +20,12 @@ const oip042EditIndex = `oip042_edit`
 const oip042TransferIndex = `oip042_transfer`
 const oip042DeactivateIndex = `oip042_deactivate`
 
-func init() {
+func initJson(hooks *module.Hooks) {
 	log.Info("init oip042 json")
-	events.SubscribeAsync("modules:oip042:json", on42Json)
+	hooks.Subscribe("modules:oip042:json", on42Json)
 
-	datastore.RegisterMapping(oip042ArtifactIndex, "oip042_artifact.json")
-	datastore.RegisterMapping(oip042PublisherIndex, "oip042_publisher.json")
-	datastore.RegisterMapping(oip042EditIndex, "oip042_edit.json")
+	httpapi.RegisterTraceReference(oip042EditIndex, "meta.originalTxid")
+	httpapi.RegisterTraceReference(oip042DeactivateIndex, "deactivate.reference")
 }
 
 func on42Json(message jsoniter.RawMessage, tx *datastore.TransactionData) {
@@ -32,6 +33,7 @@ func on42Json(message jsoniter.RawMessage, tx *datastore.TransactionData) {
 	defer t.End("on42Json", logger.Attrs{"txid": tx.Transaction.Txid})
 	if !jsoniter.Valid(message) {
 		log.Info("invalid json %s", tx.Transaction.Txid)
+		rejections.Reject(tx, "oip042", rejections.StageDecode, "invalid json", nil)
 		return
 	}
 
@@ -73,6 +75,7 @@ func on42Json(message jsoniter.RawMessage, tx *datastore.TransactionData) {
 	}
 
 	log.Error("no publisher/register message %s", tx.Transaction.Txid)
+	rejections.Reject(tx, "oip042", rejections.StageRoute, "no publish, register, edit, transfer or deactivate message", nil)
 }
 
 func on42JsonPublish(any jsoniter.Any, tx *datastore.TransactionData) {
@@ -93,6 +96,7 @@ func on42JsonPublish(any jsoniter.Any, tx *datastore.TransactionData) {
 	}
 
 	log.Error("no publish %s", tx.Transaction.Txid)
+	rejections.Reject(tx, "oip042", rejections.StageRoute, "no supported publish type", nil)
 }
 
 func on42JsonRegister(any jsoniter.Any, tx *datastore.TransactionData) {
@@ -137,6 +141,7 @@ func on42JsonRegister(any jsoniter.Any, tx *datastore.TransactionData) {
 	}
 
 	log.Error("no supported register %s", tx.Transaction.Txid)
+	rejections.Reject(tx, "oip042", rejections.StageRoute, "no supported register type", nil)
 }
 
 func on42JsonEdit(any jsoniter.Any, tx *datastore.TransactionData) {
@@ -189,6 +194,7 @@ func on42JsonEdit(any jsoniter.Any, tx *datastore.TransactionData) {
 	}
 
 	log.Error("No supported edit type %s", tx.Transaction.Txid)
+	rejections.Reject(tx, "oip042", rejections.StageRoute, "no supported edit type", nil)
 }
 
 func on42JsonTransfer(any jsoniter.Any, tx *datastore.TransactionData) {
@@ -239,6 +245,7 @@ func on42JsonTransfer(any jsoniter.Any, tx *datastore.TransactionData) {
 	}
 
 	log.Error("no supported transfer %s", tx.Transaction.Txid)
+	rejections.Reject(tx, "oip042", rejections.StageRoute, "no supported transfer type", nil)
 }
 
 func on42JsonDeactivate(any jsoniter.Any, tx *datastore.TransactionData) {
@@ -289,4 +296,5 @@ func on42JsonDeactivate(any jsoniter.Any, tx *datastore.TransactionData) {
 	}
 
 	log.Error("no supported deactivate %s", tx.Transaction.Txid)
+	rejections.Reject(tx, "oip042", rejections.StageRoute, "no supported deactivate type", nil)
 }
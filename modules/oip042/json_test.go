@@ -0,0 +1,31 @@
+package oip042
+
+import (
+	"testing"
+
+	"github.com/json-iterator/go"
+)
+
+func TestArtifactPatch(t *testing.T) {
+	p, err := artifactPatch(`[{"op":"replace","path":"/info/title","value":"new title"}]`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := p.Apply([]byte(`{"artifact":{"info":{"title":"old title"}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if title := jsoniter.Get(out, "artifact", "info", "title").ToString(); title != "new title" {
+		t.Errorf("patch not applied under artifact, got %s", out)
+	}
+
+	for _, s := range []string{
+		`[{"op":"remove"}]`,
+		`[{"op":"remove","path":1}]`,
+		`{"op":"remove","path":"/info"}`,
+	} {
+		if _, err := artifactPatch(s); err == nil {
+			t.Errorf("expected error for %s", s)
+		}
+	}
+}
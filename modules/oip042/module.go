@@ -0,0 +1,50 @@
+package oip042
+
+import (
+	"context"
+
+	"github.com/spf13/viper"
+
+	"github.com/oipwg/oip/modules/module"
+)
+
+type oip042 struct {
+	hooks module.Hooks
+}
+
+func init() {
+	module.Register(&oip042{})
+}
+
+func (o *oip042) Name() string {
+	return "oip042"
+}
+
+// Requires the oip module which decodes json: prefixed floData
+func (o *oip042) Requires() []string {
+	return []string{"oip"}
+}
+
+func (o *oip042) Mappings() map[string]string {
+	return map[string]string{
+		oip042ArtifactIndex:  "oip042_artifact.json",
+		oip042PublisherIndex: "oip042_publisher.json",
+		oip042EditIndex:      "oip042_edit.json",
+	}
+}
+
+func (o *oip042) Routes() {
+	registerRoutes()
+}
+
+func (o *oip042) Init(ctx context.Context, cfg *viper.Viper) error {
+	initJson(&o.hooks)
+	initEdit(&o.hooks)
+	initDeactivate(&o.hooks)
+	return nil
+}
+
+func (o *oip042) Shutdown(ctx context.Context) error {
+	o.hooks.Remove()
+	return nil
+}
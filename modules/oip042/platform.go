@@ -6,6 +6,7 @@ import (
 	"gopkg.in/olivere/elastic.v6"
 
 	"github.com/oipwg/oip/datastore"
+	"github.com/oipwg/oip/metrics"
 )
 
 func on42JsonRegisterPlatform(any jsoniter.Any, tx *datastore.TransactionData) {
@@ -28,7 +29,8 @@ func on42JsonRegisterPlatform(any jsoniter.Any, tx *datastore.TransactionData) {
 	}
 
 	bir := elastic.NewBulkIndexRequest().Index(datastore.Index(oip042PlatformIndex)).Type("_doc").Id(tx.Transaction.Txid).Doc(el)
-	datastore.AutoBulk.Add(bir)
+	datastore.AutoBulk.AddFor(tx, bir)
+	metrics.Accepted("oip042")
 }
 
 func on42JsonEditPlatform(any jsoniter.Any, tx *datastore.TransactionData, sig string) {
@@ -50,7 +52,8 @@ func on42JsonEditPlatform(any jsoniter.Any, tx *datastore.TransactionData, sig s
 	}
 
 	bir := elastic.NewBulkIndexRequest().Index(datastore.Index(oip042EditIndex)).Type("_doc").Id(tx.Transaction.Txid).Doc(el)
-	datastore.AutoBulk.Add(bir)
+	datastore.AutoBulk.AddFor(tx, bir)
+	metrics.Accepted("oip042")
 }
 
 func on42JsonTransferPlatform(any jsoniter.Any, tx *datastore.TransactionData) {
@@ -73,7 +76,8 @@ func on42JsonTransferPlatform(any jsoniter.Any, tx *datastore.TransactionData) {
 	}
 
 	bir := elastic.NewBulkIndexRequest().Index(datastore.Index(oip042TransferIndex)).Type("_doc").Id(tx.Transaction.Txid).Doc(el)
-	datastore.AutoBulk.Add(bir)
+	datastore.AutoBulk.AddFor(tx, bir)
+	metrics.Accepted("oip042")
 }
 
 func on42JsonDeactivatePlatform(any jsoniter.Any, tx *datastore.TransactionData) {
@@ -96,5 +100,6 @@ func on42JsonDeactivatePlatform(any jsoniter.Any, tx *datastore.TransactionData)
 	}
 
 	bir := elastic.NewBulkIndexRequest().Index(datastore.Index(oip042DeactivateIndex)).Type("_doc").Id(tx.Transaction.Txid).Doc(el)
-	datastore.AutoBulk.Add(bir)
+	datastore.AutoBulk.AddFor(tx, bir)
+	metrics.Accepted("oip042")
 }
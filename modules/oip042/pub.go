@@ -6,6 +6,7 @@ import (
 	"gopkg.in/olivere/elastic.v6"
 
 	"github.com/oipwg/oip/datastore"
+	"github.com/oipwg/oip/metrics"
 )
 
 func on42JsonRegisterPub(any jsoniter.Any, tx *datastore.TransactionData) {
@@ -34,7 +35,8 @@ func on42JsonRegisterPub(any jsoniter.Any, tx *datastore.TransactionData) {
 	}
 
 	bir := elastic.NewBulkIndexRequest().Index(datastore.Index(oip042PublisherIndex)).Type("_doc").Id(tx.Transaction.Txid).Doc(el)
-	datastore.AutoBulk.Add(bir)
+	datastore.AutoBulk.AddFor(tx, bir)
+	metrics.Accepted("oip042")
 }
 
 func on42JsonEditPub(any jsoniter.Any, tx *datastore.TransactionData, sig string) {
@@ -56,7 +58,8 @@ func on42JsonEditPub(any jsoniter.Any, tx *datastore.TransactionData, sig string
 	}
 
 	bir := elastic.NewBulkIndexRequest().Index(datastore.Index(oip042EditIndex)).Type("_doc").Id(tx.Transaction.Txid).Doc(el)
-	datastore.AutoBulk.Add(bir)
+	datastore.AutoBulk.AddFor(tx, bir)
+	metrics.Accepted("oip042")
 }
 
 func on42JsonTransferPub(any jsoniter.Any, tx *datastore.TransactionData) {
@@ -80,7 +83,8 @@ func on42JsonTransferPub(any jsoniter.Any, tx *datastore.TransactionData) {
 	}
 
 	bir := elastic.NewBulkIndexRequest().Index(datastore.Index(oip042TransferIndex)).Type("_doc").Id(tx.Transaction.Txid).Doc(el)
-	datastore.AutoBulk.Add(bir)
+	datastore.AutoBulk.AddFor(tx, bir)
+	metrics.Accepted("oip042")
 }
 
 func on42JsonDeactivatePub(any jsoniter.Any, tx *datastore.TransactionData) {
@@ -103,5 +107,6 @@ func on42JsonDeactivatePub(any jsoniter.Any, tx *datastore.TransactionData) {
 	}
 
 	bir := elastic.NewBulkIndexRequest().Index(datastore.Index(oip042DeactivateIndex)).Type("_doc").Id(tx.Transaction.Txid).Doc(el)
-	datastore.AutoBulk.Add(bir)
+	datastore.AutoBulk.AddFor(tx, bir)
+	metrics.Accepted("oip042")
 }
@@ -0,0 +1,19 @@
+//go:build go1.18
+// +build go1.18
+
+package validators
+
+import (
+	"testing"
+
+	"github.com/json-iterator/go"
+)
+
+func FuzzIsValidArtifact(f *testing.F) {
+	f.Add("property", "party", []byte(`{"floAddress":"FTSTq8xx8yWUKJA5E3bgXLzZqqG9V6dvnr","timestamp":1525138120,"type":"property","subtype":"party","info":{"title":"the title","description":" "},"details":{"ns":"somethingnamespace","partyType":"INDIVIDUAL"},"signature":"phonysignature"}`))
+	f.Add("research", "tomogram", []byte(`{"info":{"title":"t"},"details":{"date":1,"NCBItaxID":2}}`))
+	f.Fuzz(func(t *testing.T, artType, artSubType string, b []byte) {
+		art := jsoniter.ConfigDefault.Get(b)
+		_ = IsValidArtifact(artType, artSubType, &art, "")
+	})
+}
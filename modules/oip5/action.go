@@ -0,0 +1,117 @@
+package oip5
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/azer/logger"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/jhump/protoreflect/dynamic"
+	"github.com/oipwg/proto/go/pb_oip5"
+	"github.com/spf13/viper"
+
+	"github.com/oipwg/oip/modules/oip5/templates"
+)
+
+// actionTemplate is a published template, chosen by the operator by its txid,
+// whose detail attached alone to a record makes the record an action upon the
+// record it references rather than content of its own
+//
+// Details are decoded with the descriptor of the published template, and only
+// once the template holds its identifier, so that no other template sharing
+// the identifier is mistaken for it
+type actionTemplate struct {
+	// action named in errors, ex: deactivation
+	action string
+	// config key holding the txid of the template
+	key string
+	// string fields the template must declare besides its txid reference
+	fields []string
+
+	txid string
+	name string
+}
+
+// configure sets the template txid from cfg, leaving the action disabled if it
+// is unset or invalid
+func (a *actionTemplate) configure(cfg *viper.Viper) {
+	a.txid, a.name = "", ""
+	txid := strings.ToLower(cfg.GetString(a.key))
+	if txid == "" {
+		log.Info("oip5 action template not configured", logger.Attrs{"action": a.action, "key": a.key})
+		return
+	}
+	if _, err := hex.DecodeString(txid); err != nil || len(txid) != 64 {
+		log.Error("invalid oip5 action template txid", logger.Attrs{"action": a.action, "key": a.key, "txid": txid})
+		return
+	}
+	a.txid = txid
+	a.name = "tmpl_" + strings.ToUpper(txid[:8])
+}
+
+// template returns the configured template, nil if it is not yet indexed or
+// its identifier is held by an earlier template
+func (a *actionTemplate) template() *templates.RecordTemplate {
+	if a.txid == "" {
+		return nil
+	}
+	tmpl, err := templates.GetTemplate(a.txid)
+	if err != nil || tmpl == nil || tmpl.Txid != a.txid {
+		return nil
+	}
+	return tmpl
+}
+
+// of reports whether r carries a detail of the template
+func (a *actionTemplate) of(r *pb_oip5.RecordProto) bool {
+	return a.name != "" && hasDetail(r, a.name) && a.template() != nil
+}
+
+// decode decodes the only detail of r as the template, returning it along
+// with the txid it references
+func (a *actionTemplate) decode(r *pb_oip5.RecordProto) (*dynamic.Message, string, error) {
+	details := r.GetDetails().GetDetails()
+	if len(details) != 1 {
+		return nil, "", fmt.Errorf("%s must be the only detail of a record", a.action)
+	}
+	tmpl := a.template()
+	if tmpl == nil {
+		return nil, "", fmt.Errorf("%s template %s not indexed", a.action, a.txid)
+	}
+
+	md := tmpl.MessageDescriptor
+	if fd := md.FindFieldByName("reference"); fd == nil || fd.IsRepeated() || fd.GetMessageType() == nil ||
+		fd.GetMessageType().GetFullyQualifiedName() != txidMessageName {
+		return nil, "", fmt.Errorf("%s template lacks a txid reference field", a.action)
+	}
+	for _, f := range a.fields {
+		if fd := md.FindFieldByName(f); fd == nil || fd.IsRepeated() || fd.GetType() != descriptor.FieldDescriptorProto_TYPE_STRING {
+			return nil, "", fmt.Errorf("%s template lacks a string %s field", a.action, f)
+		}
+	}
+
+	dm := dynamic.NewMessageWithMessageFactory(md, templates.TemplateMessageFactory)
+	err := dm.Unmarshal(details[0].Value)
+	if err != nil {
+		return nil, "", err
+	}
+	ref, ok := txidValue(dm.GetFieldByName("reference"))
+	if !ok || ref == "" {
+		return nil, "", fmt.Errorf("missing %s reference", a.action)
+	}
+	return dm, ref, nil
+}
+
+// hasDetail reports whether r carries a detail of the named template
+func hasDetail(r *pb_oip5.RecordProto, template string) bool {
+	if r.Details == nil {
+		return false
+	}
+	for _, d := range r.Details.Details {
+		if strings.EqualFold(d.TypeUrl[strings.LastIndex(d.TypeUrl, ".")+1:], template) {
+			return true
+		}
+	}
+	return false
+}
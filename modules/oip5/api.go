@@ -2,36 +2,255 @@ package oip5
 
 import (
 	"errors"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 
 	"github.com/gorilla/mux"
+	"github.com/oipwg/proto/go/pb_oip5"
 	"gopkg.in/olivere/elastic.v6"
 
 	"github.com/oipwg/oip/datastore"
 	"github.com/oipwg/oip/httpapi"
+	"github.com/oipwg/oip/modules/oip5/templates"
 )
 
 const o5RecordIndexName = "oip5_record"
 const o5TemplateIndexName = "oip5_templates"
 
-var o5Router = httpapi.NewSubRoute("/o5")
-
-func init() {
-	o5Router.HandleFunc("/record/search", handleRecordSearch).Queries("q", "{query}")
-	o5Router.HandleFunc("/template/search", handleTemplateSearch).Queries("q", "{query}")
-	o5Router.HandleFunc("/record/get/latest", handleLatestRecord)
-	o5Router.HandleFunc("/record/get/{id:[a-f0-9]+}", handleGetRecord)
-	o5Router.HandleFunc("/record/mapping/{tmpl:tmpl_[a-fA-F0-9]{8}(?:,tmpl_[a-fA-F0-9]{8})*}", handleGetMapping)
-	o5Router.HandleFunc("/template/get/latest", handleLatestTemplate)
-	o5Router.HandleFunc("/template/get/{id:[a-fA-F0-9]+}", handleGetTemplate)
+func registerRoutes() {
+	o5Router := httpapi.NewSubRoute("/o5")
+	o5Router.HandleFunc("/record/search", handleRecordSearch, httpapi.RouteDoc{
+		Summary:  "Search oip5 records with an Elasticsearch query string",
+		Paged:    true,
+		Response: httpapi.SearchResponse(nil),
+	}).Queries("q", "{query}")
+	o5Router.HandleFunc("/template/search", handleTemplateSearch, httpapi.RouteDoc{
+		Summary:  "Search oip5 templates with an Elasticsearch query string",
+		Paged:    true,
+		Response: httpapi.SearchResponse(nil),
+	}).Queries("q", "{query}")
+	o5Router.HandleFunc("/record/search", o5RecordSearch.HandleSearch,
+		o5RecordSearch.SearchDoc("Search oip5 records with a json search query")).Methods("POST")
+	o5Router.HandleFunc("/template/search", o5TemplateSearch.HandleSearch,
+		o5TemplateSearch.SearchDoc("Search oip5 templates with a json search query")).Methods("POST")
+	o5Router.HandleFunc("/record/facets", o5RecordSearch.HandleFacets,
+		o5RecordSearch.FacetsDoc("Facets over oip5 records")).Methods("POST")
+	o5Router.HandleFunc("/template/facets", o5TemplateSearch.HandleFacets,
+		o5TemplateSearch.FacetsDoc("Facets over oip5 templates")).Methods("POST")
+	o5Router.HandleFunc("/record/export", o5RecordSearch.HandleExport,
+		o5RecordSearch.ExportDoc("Export all oip5 records matching a json search query")).Methods("GET", "POST")
+	o5Router.HandleFunc("/template/export", o5TemplateSearch.HandleExport,
+		o5TemplateSearch.ExportDoc("Export all oip5 templates matching a json search query")).Methods("GET", "POST")
+	o5Router.HandleFunc("/record/get/latest", handleLatestRecord, httpapi.RouteDoc{
+		Summary:  "Latest oip5 records",
+		Paged:    true,
+		Response: httpapi.SearchResponse(nil),
+	})
+	o5Router.HandleFunc("/record/get/{id:[a-f0-9]+}", handleGetRecord, httpapi.RouteDoc{
+		Summary:  "oip5 records by txid or txid prefix",
+		Response: httpapi.SearchResponse(nil),
+	})
+	o5Router.HandleFunc("/record/encode", handleEncodeRecord, httpapi.RouteDoc{
+		Summary:     "Serialize an oip5 record from its json form for signing",
+		Description: "The body is a record as served by the api, with details keyed by template name. Returns the unsigned serialized message and the expected floData size once signed.",
+		Params: []httpapi.Param{
+			{Name: "address", Description: "signing address, used to size the floData"},
+		},
+		Body:     &httpapi.Schema{Type: "object", Description: "oip5 record json"},
+		Response: httpapi.SchemaOf(EncodedRecord{}),
+	}).Methods("POST")
+	o5Router.HandleFunc("/record/history/{original:[a-f0-9]{64}}", handleRecordHistory, httpapi.RouteDoc{
+		Summary:  "Every revision of an oip5 record along with the transaction which produced it",
+		Response: httpapi.AnyObject,
+	})
+	o5Router.HandleFunc("/record/diff/{original:[a-f0-9]{64}}", handleRecordDiff, httpapi.RouteDoc{
+		Summary: "Field level differences between two revisions of an oip5 record",
+		Params: []httpapi.Param{
+			{Name: "from", Description: "txid of the earlier revision, defaults to the revision preceding to"},
+			{Name: "to", Description: "txid of the later revision, defaults to the latest revision"},
+		},
+		Response: httpapi.AnyObject,
+	})
+	o5Router.HandleFunc("/record/mapping/{tmpl:tmpl_[a-fA-F0-9]{8}(?:,tmpl_[a-fA-F0-9]{8})*}", handleGetMapping, httpapi.RouteDoc{
+		Summary:  "Elasticsearch mapping of record details for the comma separated templates",
+		Response: httpapi.AnyObject,
+	})
+	o5Router.HandleFunc("/template/get/latest", handleLatestTemplate, httpapi.RouteDoc{
+		Summary:  "Latest oip5 templates",
+		Paged:    true,
+		Response: httpapi.SearchResponse(nil),
+	})
+	o5Router.HandleFunc("/template/get/{id:[a-fA-F0-9]+}", handleGetTemplate, httpapi.RouteDoc{
+		Summary:  "oip5 templates by txid or txid prefix",
+		Response: httpapi.SearchResponse(nil),
+	})
+	o5Router.HandleFunc("/template/{id:(?:tmpl_)?[a-fA-F0-9]{8,64}}/proto", handleTemplateProto, httpapi.RouteDoc{
+		Summary:     "oip5 template as .proto source, by txid, txid prefix or template name",
+		ContentType: "text/plain",
+	})
+	o5Router.HandleFunc("/template/{id:(?:tmpl_)?[a-fA-F0-9]{8,64}}/jsonschema", handleTemplateJSONSchema, httpapi.RouteDoc{
+		Summary:     "JSON Schema of records using an oip5 template and the templates it extends",
+		Description: "Describes the json accepted by `POST /o5/record/encode`.",
+		Response:    httpapi.AnyObject,
+	})
+	o5Router.HandleFunc("/deactivate/get/latest", handleLatestDeactivate, httpapi.RouteDoc{
+		Summary:  "Latest oip5 deactivations",
+		Paged:    true,
+		Response: httpapi.SearchResponse(nil),
+	})
+	o5Router.HandleFunc("/deactivate/get/{id:[a-f0-9]+}", handleGetDeactivate, httpapi.RouteDoc{
+		Summary:  "oip5 deactivations by txid or txid prefix",
+		Response: httpapi.SearchResponse(nil),
+	})
+	o5Router.HandleFunc("/deactivate/record/{id:[a-f0-9]+}", handleRecordDeactivations, httpapi.RouteDoc{
+		Summary:  "Deactivation history of an oip5 record, including pending and invalid deactivations",
+		Paged:    true,
+		Response: httpapi.SearchResponse(nil),
+	})
+	o5Router.HandleFunc("/transfer/get/{id:[a-f0-9]+}", handleGetTransfer, httpapi.RouteDoc{
+		Summary:  "oip5 ownership transfers by txid or txid prefix",
+		Response: httpapi.SearchResponse(nil),
+	})
+	o5Router.HandleFunc("/transfer/record/{id:[a-f0-9]+}", handleRecordTransfers, httpapi.RouteDoc{
+		Summary:  "Ownership transfers of an oip5 record, including pending and invalid transfers",
+		Paged:    true,
+		Response: httpapi.SearchResponse(nil),
+	})
+	o5Router.HandleFunc("/reference/{id:[a-f0-9]+}/out", handleReferencesOut, httpapi.RouteDoc{
+		Summary:  "References from an oip5 record to other records",
+		Params:   referenceParams,
+		Paged:    true,
+		Response: httpapi.SearchResponse(nil),
+	})
+	o5Router.HandleFunc("/reference/{id:[a-f0-9]+}/in", handleReferencesIn, httpapi.RouteDoc{
+		Summary:  "References to an oip5 record from other records",
+		Params:   referenceParams,
+		Paged:    true,
+		Response: httpapi.SearchResponse(nil),
+	})
+	o5Router.HandleFunc("/reference/{id:[a-f0-9]{64}}/traverse", handleTraverseReferences, httpapi.RouteDoc{
+		Summary:     "Records reachable from an oip5 record through its references",
+		Description: "Follows references breadth first, each record is visited once. At most " + strconv.Itoa(maxTraverseEdges) + " edges are followed per hop.",
+		Params: append([]httpapi.Param{
+			{Name: "direction", Description: "out follows references made by the records, in those made to them", Schema: &httpapi.Schema{Type: "string", Enum: []string{referencesOut, referencesIn}}},
+			{Name: "depth", Description: "number of hops, 1 to " + strconv.Itoa(maxTraverseDepth), Schema: &httpapi.Schema{Type: "integer"}},
+		}, referenceParams...),
+		Response: httpapi.AnyObject,
+	})
+	o5Router.HandleFunc("/normalize/get/latest", handleLatestNormalizer, httpapi.RouteDoc{
+		Summary:  "Latest oip5 normalizers",
+		Paged:    true,
+		Response: httpapi.SearchResponse(nil),
+	})
+	o5Router.HandleFunc("/normalize/get/{id:[a-f0-9]+}", handleGetNormalizer, httpapi.RouteDoc{
+		Summary:  "oip5 normalizers by txid or txid prefix",
+		Response: httpapi.SearchResponse(nil),
+	})
+	o5Router.HandleFunc("/normalize/record/search", o5NormalizedSearch.HandleSearch,
+		o5NormalizedSearch.SearchDoc("Search normalized oip5 records with a json search query")).Methods("POST")
+	o5Router.HandleFunc("/normalize/{id:[a-f0-9]+}/record/search", handleNormalizedSearch, httpapi.RouteDoc{
+		Summary:  "Search oip5 records normalized by a normalizer with an Elasticsearch query string",
+		Paged:    true,
+		Response: httpapi.SearchResponse(nil),
+	}).Queries("q", "{query}")
+	o5Router.HandleFunc("/normalize/{id:[a-f0-9]+}/record/get/latest", handleLatestNormalized, httpapi.RouteDoc{
+		Summary:  "Latest oip5 records normalized by a normalizer",
+		Paged:    true,
+		Response: httpapi.SearchResponse(nil),
+	})
+	o5Router.HandleFunc("/normalize/{id:[a-f0-9]+}/record/get/{record:[a-f0-9]+}", handleGetNormalized, httpapi.RouteDoc{
+		Summary:  "oip5 records normalized by a normalizer by txid or txid prefix",
+		Response: httpapi.SearchResponse(nil),
+	})
 }
 
 var (
 	o5Indices = []string{o5RecordIndexName}
 	o5Fsc     = elastic.NewFetchSourceContext(true).
-			Include("record.*", "template.*", "file_descriptor_set", "meta.publisher_name", "meta.signed_by", "meta.block_hash", "meta.txid", "meta.block", "meta.time", "meta.type")
+			Include("record.*", "template.*", "file_descriptor_set", "meta.publisher_name", "meta.signed_by", "meta.owner", "meta.owner_history", "meta.validation", "meta.block_hash", "meta.txid", "meta.block", "meta.time", "meta.type")
+	o5Sorts = []elastic.SortInfo{
+		{Field: "meta.time", Ascending: false},
+		{Field: "meta.txid", Ascending: true},
+	}
+	o5RecordSearch = &httpapi.SearchResource{
+		Indices: o5Indices,
+		Fields: httpapi.SearchFields{
+			"record.details.*":            httpapi.DynamicField,
+			"record.tags":                 httpapi.TextField,
+			"meta.block":                  httpapi.NumericField,
+			"meta.time":                   httpapi.DateField,
+			"meta.last_modified":          httpapi.DateField,
+			"meta.txid":                   httpapi.KeywordField,
+			"meta.original":               httpapi.KeywordField,
+			"meta.signed_by":              httpapi.KeywordField,
+			"meta.owner":                  httpapi.KeywordField,
+			"meta.validation.status":      httpapi.KeywordField,
+			"meta.publisher_name":         httpapi.TextField,
+			"meta.publisher_name.keyword": httpapi.KeywordField,
+			"meta.templates":              httpapi.KeywordField,
+		},
+		Filters: []elastic.Query{
+			elastic.NewTermQuery("meta.deactivated", false),
+			elastic.NewTermQuery("meta.latest", true),
+		},
+		Sorts: o5Sorts,
+		Fsc:   o5Fsc,
+	}
+	o5TemplateSearch = &httpapi.SearchResource{
+		Indices: []string{o5TemplateIndexName},
+		Fields: httpapi.SearchFields{
+			"template.friendly_name":         httpapi.TextField,
+			"template.friendly_name.keyword": httpapi.KeywordField,
+			"template.description":           httpapi.TextField,
+			"template.name":                  httpapi.KeywordField,
+			"template.identifier":            httpapi.NumericField,
+			"template.extends":               httpapi.NumericField,
+			"meta.block":                     httpapi.NumericField,
+			"meta.time":                      httpapi.NumericField,
+			"meta.txid":                      httpapi.KeywordField,
+			"meta.signed_by":                 httpapi.KeywordField,
+		},
+		Sorts: o5Sorts,
+		Fsc:   o5Fsc,
+	}
+	o5DeactivateFsc = elastic.NewFetchSourceContext(true).
+			Include("deactivate.*", "meta.applied", "meta.invalid", "meta.signed_by", "meta.block_hash", "meta.txid", "meta.block", "meta.time")
+	o5TransferFsc = elastic.NewFetchSourceContext(true).
+			Include("transfer.*", "meta.applied", "meta.invalid", "meta.signed_by", "meta.block_hash", "meta.txid", "meta.block", "meta.time")
+	o5ReferenceFsc = elastic.NewFetchSourceContext(true).
+			Include("reference.*", "meta.original", "meta.txid", "meta.signed_by", "meta.time")
+	referenceParams = []httpapi.Param{
+		{Name: "template", Description: "only references made by details of this template, e.g. tmpl_C0FFEE02"},
+		{Name: "field", Description: "only references made by this field, nested fields are dotted paths"},
+	}
+	o5NormalizerFsc = elastic.NewFetchSourceContext(true).
+			Include("normalizer.*", "meta.name", "meta.main_template", "meta.history", "meta.signed_by", "meta.block_hash", "meta.txid", "meta.block", "meta.time", "meta.last_modified")
+	o5NormalizedFsc = elastic.NewFetchSourceContext(true).
+			Include("normalized.*", "meta.normalizer", "meta.name", "meta.original", "meta.txid", "meta.publisher_name", "meta.signed_by", "meta.time", "meta.last_modified")
+	o5NormalizedSearch = &httpapi.SearchResource{
+		Indices: []string{normalizedIndex},
+		Fields: httpapi.SearchFields{
+			"normalized.*":                httpapi.DynamicField,
+			"meta.normalizer":             httpapi.KeywordField,
+			"meta.name":                   httpapi.KeywordField,
+			"meta.original":               httpapi.KeywordField,
+			"meta.txid":                   httpapi.KeywordField,
+			"meta.signed_by":              httpapi.KeywordField,
+			"meta.publisher_name":         httpapi.TextField,
+			"meta.publisher_name.keyword": httpapi.KeywordField,
+			"meta.time":                   httpapi.DateField,
+			"meta.last_modified":          httpapi.DateField,
+		},
+		Filters: []elastic.Query{
+			elastic.NewTermQuery("meta.deactivated", false),
+		},
+		Sorts: o5Sorts,
+		Fsc:   o5NormalizedFsc,
+	}
 )
 
 func handleRecordSearch(w http.ResponseWriter, r *http.Request) {
@@ -206,3 +425,422 @@ func handleGetTemplate(w http.ResponseWriter, r *http.Request) {
 
 	httpapi.RespondSearch(r.Context(), w, searchService)
 }
+
+func handleLatestNormalizer(w http.ResponseWriter, r *http.Request) {
+
+	q := elastic.NewTermQuery("meta.invalid", false)
+
+	searchService := httpapi.BuildCommonSearchService(
+		r.Context(),
+		[]string{normalizeIndex},
+		q,
+		[]elastic.SortInfo{{Field: "meta.time", Ascending: false}},
+		o5NormalizerFsc,
+	)
+
+	httpapi.RespondSearch(r.Context(), w, searchService)
+}
+
+func handleGetNormalizer(w http.ResponseWriter, r *http.Request) {
+	var opts = mux.Vars(r)
+
+	q := elastic.NewBoolQuery().Must(
+		elastic.NewPrefixQuery("meta.txid", opts["id"]),
+		elastic.NewTermQuery("meta.invalid", false),
+	)
+
+	searchService := httpapi.BuildCommonSearchService(
+		r.Context(),
+		[]string{normalizeIndex},
+		q,
+		[]elastic.SortInfo{{Field: "meta.time", Ascending: false}},
+		o5NormalizerFsc,
+	)
+
+	httpapi.RespondSearch(r.Context(), w, searchService)
+}
+
+func handleNormalizedSearch(w http.ResponseWriter, r *http.Request) {
+	var opts = mux.Vars(r)
+
+	searchQuery, err := url.PathUnescape(opts["query"])
+	if err != nil {
+		httpapi.RespondJSON(r.Context(), w, 400, map[string]interface{}{
+			"error": "unable to decode query",
+		})
+		return
+	}
+
+	query := elastic.NewBoolQuery().Must(
+		elastic.NewQueryStringQuery(searchQuery).
+			AnalyzeWildcard(false),
+		elastic.NewPrefixQuery("meta.normalizer", opts["id"]),
+		elastic.NewTermQuery("meta.deactivated", false),
+	)
+
+	searchService := httpapi.BuildCommonSearchService(
+		r.Context(),
+		[]string{normalizedIndex},
+		query,
+		o5Sorts,
+		o5NormalizedFsc,
+	)
+
+	httpapi.RespondSearch(r.Context(), w, searchService)
+}
+
+func handleLatestNormalized(w http.ResponseWriter, r *http.Request) {
+	var opts = mux.Vars(r)
+
+	q := elastic.NewBoolQuery().Must(
+		elastic.NewPrefixQuery("meta.normalizer", opts["id"]),
+		elastic.NewTermQuery("meta.deactivated", false),
+	)
+
+	searchService := httpapi.BuildCommonSearchService(
+		r.Context(),
+		[]string{normalizedIndex},
+		q,
+		[]elastic.SortInfo{{Field: "meta.time", Ascending: false}},
+		o5NormalizedFsc,
+	)
+
+	httpapi.RespondSearch(r.Context(), w, searchService)
+}
+
+func handleGetNormalized(w http.ResponseWriter, r *http.Request) {
+	var opts = mux.Vars(r)
+
+	q := elastic.NewBoolQuery().Must(
+		elastic.NewPrefixQuery("meta.normalizer", opts["id"]),
+		elastic.NewPrefixQuery("meta.original", opts["record"]),
+	)
+
+	searchService := httpapi.BuildCommonSearchService(
+		r.Context(),
+		[]string{normalizedIndex},
+		q,
+		[]elastic.SortInfo{{Field: "meta.time", Ascending: false}},
+		o5NormalizedFsc,
+	)
+
+	httpapi.RespondSearch(r.Context(), w, searchService)
+}
+
+func handleLatestDeactivate(w http.ResponseWriter, r *http.Request) {
+
+	q := elastic.NewTermQuery("meta.applied", true)
+
+	searchService := httpapi.BuildCommonSearchService(
+		r.Context(),
+		[]string{deactivateIndex},
+		q,
+		[]elastic.SortInfo{{Field: "meta.time", Ascending: false}},
+		o5DeactivateFsc,
+	)
+
+	httpapi.RespondSearch(r.Context(), w, searchService)
+}
+
+func handleGetDeactivate(w http.ResponseWriter, r *http.Request) {
+	var opts = mux.Vars(r)
+
+	q := elastic.NewPrefixQuery("meta.txid", opts["id"])
+
+	searchService := httpapi.BuildCommonSearchService(
+		r.Context(),
+		[]string{deactivateIndex},
+		q,
+		[]elastic.SortInfo{{Field: "meta.time", Ascending: false}},
+		o5DeactivateFsc,
+	)
+
+	httpapi.RespondSearch(r.Context(), w, searchService)
+}
+
+func handleRecordDeactivations(w http.ResponseWriter, r *http.Request) {
+	var opts = mux.Vars(r)
+
+	q := elastic.NewPrefixQuery("deactivate.reference", opts["id"])
+
+	searchService := httpapi.BuildCommonSearchService(
+		r.Context(),
+		[]string{deactivateIndex},
+		q,
+		[]elastic.SortInfo{{Field: "meta.time", Ascending: false}},
+		o5DeactivateFsc,
+	)
+
+	httpapi.RespondSearch(r.Context(), w, searchService)
+}
+
+func handleGetTransfer(w http.ResponseWriter, r *http.Request) {
+	var opts = mux.Vars(r)
+
+	q := elastic.NewPrefixQuery("meta.txid", opts["id"])
+
+	searchService := httpapi.BuildCommonSearchService(
+		r.Context(),
+		[]string{transferIndex},
+		q,
+		[]elastic.SortInfo{{Field: "meta.time", Ascending: false}},
+		o5TransferFsc,
+	)
+
+	httpapi.RespondSearch(r.Context(), w, searchService)
+}
+
+func handleRecordTransfers(w http.ResponseWriter, r *http.Request) {
+	var opts = mux.Vars(r)
+
+	q := elastic.NewPrefixQuery("transfer.reference", opts["id"])
+
+	searchService := httpapi.BuildCommonSearchService(
+		r.Context(),
+		[]string{transferIndex},
+		q,
+		[]elastic.SortInfo{{Field: "meta.time", Ascending: false}},
+		o5TransferFsc,
+	)
+
+	httpapi.RespondSearch(r.Context(), w, searchService)
+}
+
+func handleReferencesOut(w http.ResponseWriter, r *http.Request) {
+	handleReferences(w, r, referencesOut)
+}
+
+func handleReferencesIn(w http.ResponseWriter, r *http.Request) {
+	handleReferences(w, r, referencesIn)
+}
+
+func handleReferences(w http.ResponseWriter, r *http.Request, direction string) {
+	var opts = mux.Vars(r)
+
+	q := referenceFilters(
+		elastic.NewPrefixQuery(referenceKey(direction), opts["id"]),
+		r.FormValue("template"),
+		r.FormValue("field"),
+	)
+
+	searchService := httpapi.BuildCommonSearchService(
+		r.Context(),
+		[]string{referenceIndex},
+		q,
+		o5Sorts,
+		o5ReferenceFsc,
+	)
+
+	httpapi.RespondSearch(r.Context(), w, searchService)
+}
+
+func handleTraverseReferences(w http.ResponseWriter, r *http.Request) {
+	var opts = mux.Vars(r)
+
+	direction := r.FormValue("direction")
+	if direction == "" {
+		direction = referencesOut
+	}
+	if direction != referencesOut && direction != referencesIn {
+		httpapi.RespondJSON(r.Context(), w, 400, map[string]interface{}{
+			"error": "direction must be out or in",
+		})
+		return
+	}
+
+	depth := 1
+	if d := r.FormValue("depth"); d != "" {
+		var err error
+		depth, err = strconv.Atoi(d)
+		if err != nil || depth < 1 || depth > maxTraverseDepth {
+			httpapi.RespondJSON(r.Context(), w, 400, map[string]interface{}{
+				"error": "depth must be between 1 and " + strconv.Itoa(maxTraverseDepth),
+			})
+			return
+		}
+	}
+
+	hops, err := traverseReferences(r.Context(), opts["id"], direction, depth, r.FormValue("template"), r.FormValue("field"))
+	if err != nil {
+		httpapi.RespondESError(r.Context(), w, err)
+		return
+	}
+
+	httpapi.RespondJSON(r.Context(), w, 200, map[string]interface{}{
+		"root":      opts["id"],
+		"direction": direction,
+		"hops":      hops,
+	})
+}
+
+func handleRecordHistory(w http.ResponseWriter, r *http.Request) {
+	var opts = mux.Vars(r)
+
+	revs, err := recordRevisions(r.Context(), opts["original"])
+	if err == errRecordNotFound {
+		httpapi.RespondJSON(r.Context(), w, 404, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		httpapi.RespondESError(r.Context(), w, err)
+		return
+	}
+
+	httpapi.RespondJSON(r.Context(), w, 200, map[string]interface{}{
+		"original":  opts["original"],
+		"revisions": revs,
+	})
+}
+
+func handleRecordDiff(w http.ResponseWriter, r *http.Request) {
+	var opts = mux.Vars(r)
+
+	revs, err := recordRevisions(r.Context(), opts["original"])
+	if err == errRecordNotFound {
+		httpapi.RespondJSON(r.Context(), w, 404, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		httpapi.RespondESError(r.Context(), w, err)
+		return
+	}
+
+	to := len(revs) - 1
+	if txid := r.FormValue("to"); txid != "" {
+		to = revisionIndex(revs, txid)
+	}
+	from := to - 1
+	if txid := r.FormValue("from"); txid != "" {
+		from = revisionIndex(revs, txid)
+	}
+	if to == -1 || (from == -1 && r.FormValue("from") != "") {
+		httpapi.RespondJSON(r.Context(), w, 400, map[string]interface{}{
+			"error": "unknown revision",
+		})
+		return
+	}
+
+	next, err := revs[to].decode()
+	if err != nil {
+		httpapi.RespondJSON(r.Context(), w, 500, map[string]interface{}{
+			"error": "unable to decode revision",
+		})
+		return
+	}
+	var prev *pb_oip5.RecordProto
+	res := map[string]interface{}{
+		"original": opts["original"],
+		"to":       revs[to].Txid,
+	}
+	if from >= 0 {
+		res["from"] = revs[from].Txid
+		prev, err = revs[from].decode()
+		if err != nil {
+			httpapi.RespondJSON(r.Context(), w, 500, map[string]interface{}{
+				"error": "unable to decode revision",
+			})
+			return
+		}
+	}
+
+	changes, err := diffRecords(prev, next)
+	if err != nil {
+		httpapi.RespondJSON(r.Context(), w, 500, map[string]interface{}{
+			"error": "unable to compare revisions",
+		})
+		return
+	}
+	res["changes"] = changes
+
+	httpapi.RespondJSON(r.Context(), w, 200, res)
+}
+
+func handleEncodeRecord(w http.ResponseWriter, r *http.Request) {
+	b, err := ioutil.ReadAll(io.LimitReader(r.Body, maxEncodeBodySize+1))
+	if err != nil || len(b) > maxEncodeBodySize {
+		httpapi.RespondJSON(r.Context(), w, 400, map[string]interface{}{
+			"error": "unable to read record",
+		})
+		return
+	}
+
+	rec, err := decodeRecordJson(b)
+	if err != nil {
+		httpapi.RespondJSON(r.Context(), w, 400, map[string]interface{}{
+			"error": "unable to decode record: " + err.Error(),
+		})
+		return
+	}
+
+	v := validateRecord(rec)
+	if v != nil && v.Status == validationInvalid {
+		httpapi.RespondJSON(r.Context(), w, 400, map[string]interface{}{
+			"error":    "invalid record",
+			"problems": v.Problems,
+		})
+		return
+	}
+
+	enc, err := encodeRecord(rec, r.FormValue("address"))
+	if err != nil {
+		httpapi.RespondJSON(r.Context(), w, 500, map[string]interface{}{
+			"error": "unable to encode record",
+		})
+		return
+	}
+	enc.Validation = v
+
+	httpapi.RespondJSON(r.Context(), w, 200, enc)
+}
+
+// templateById returns the template of a txid, txid prefix or template name
+func templateById(id string) *templates.RecordTemplate {
+	id = strings.ToLower(strings.TrimPrefix(id, "tmpl_"))
+	tmpl, err := templates.GetTemplate(id)
+	if err != nil || tmpl == nil || !strings.HasPrefix(tmpl.Txid, id) {
+		return nil
+	}
+	return tmpl
+}
+
+func handleTemplateProto(w http.ResponseWriter, r *http.Request) {
+	var opts = mux.Vars(r)
+
+	tmpl := templateById(opts["id"])
+	if tmpl == nil {
+		httpapi.RespondJSON(r.Context(), w, 404, map[string]interface{}{
+			"error": "template not found",
+		})
+		return
+	}
+
+	src, err := tmpl.ProtoSource()
+	if err != nil {
+		httpapi.RespondJSON(r.Context(), w, 500, map[string]interface{}{
+			"error": "unable to render template",
+		})
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(200)
+	_, _ = w.Write([]byte(src))
+}
+
+func handleTemplateJSONSchema(w http.ResponseWriter, r *http.Request) {
+	var opts = mux.Vars(r)
+
+	tmpl := templateById(opts["id"])
+	if tmpl == nil {
+		httpapi.RespondJSON(r.Context(), w, 404, map[string]interface{}{
+			"error": "template not found",
+		})
+		return
+	}
+
+	httpapi.RespondJSON(r.Context(), w, 200, tmpl.JSONSchema())
+}
@@ -0,0 +1,96 @@
+package oip5
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/azer/logger"
+	"gopkg.in/olivere/elastic.v6"
+
+	"github.com/oipwg/oip/datastore"
+)
+
+// backfillRecords derives the meta and references of records indexed before
+// they were recorded at intake, those records being found by their lack of
+// meta.templates
+func backfillRecords(ctx context.Context) error {
+	searchSize := 1000
+	q := elastic.NewBoolQuery().
+		Must(elastic.NewExistsQuery("meta.record_raw")).
+		MustNot(elastic.NewExistsQuery("meta.templates"))
+
+	backfilled := 0
+	var after []interface{}
+	for {
+		search := datastore.Client().
+			Search(datastore.Index(o5RecordIndexName)).
+			Type("_doc").
+			Query(q).
+			Size(searchSize).
+			Sort("meta.txid", true)
+		if after != nil {
+			search.SearchAfter(after...)
+		}
+		res, err := search.Do(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, h := range res.Hits.Hits {
+			var eRec elasticOip5Record
+			err := json.Unmarshal(*h.Source, &eRec)
+			if err != nil {
+				log.Info("failed to unmarshal elastic hit", logger.Attrs{"err": err})
+				continue
+			}
+			rec, err := decodeElasticRecord(eRec)
+			if err != nil {
+				log.Error("unable to decode record", logger.Attrs{"err": err, "txid": eRec.Meta.Txid})
+				continue
+			}
+			if backfillRecord(h.Id, rec) {
+				backfilled++
+			}
+		}
+
+		if len(res.Hits.Hits) < searchSize {
+			break
+		}
+		after = res.Hits.Hits[len(res.Hits.Hits)-1].Sort
+	}
+
+	if backfilled > 0 {
+		log.Info("backfilled records", logger.Attrs{"records": backfilled})
+		datastore.AutoBulk.Commit()
+	}
+	return nil
+}
+
+// backfillRecord updates the record stored as id with the meta derived at
+// intake and, for the latest revision, indexes its references, returning
+// false if there is no meta to derive
+func backfillRecord(id string, rec *oip5Record) bool {
+	names := recordTemplateNames(rec.Record)
+	if len(names) == 0 {
+		return false
+	}
+	// references are kept for the latest revision of each record only
+	if rec.Meta.Latest {
+		indexReferences(rec, nil, nil)
+	}
+
+	bur := elastic.NewBulkUpdateRequest().
+		Index(datastore.Index(o5RecordIndexName)).
+		Type("_doc").
+		Id(id).
+		Doc(MetaTemplates{Templates{names}})
+	datastore.AutoBulk.Add(bur)
+	return true
+}
+
+type Templates struct {
+	Templates []string `json:"templates"`
+}
+type MetaTemplates struct {
+	Meta Templates `json:"meta"`
+}
@@ -0,0 +1,71 @@
+package oip5
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/oipwg/proto/go/pb_oip"
+
+	"github.com/oipwg/oip/datastore"
+	"github.com/oipwg/oip/datastore/datastoretest"
+)
+
+func TestBackfillRecords(t *testing.T) {
+	registerTestTemplates(t)
+
+	s := datastoretest.NewServer()
+	defer s.Close()
+	datastore.SetClient(s.Client())
+
+	// records indexed before meta.templates and references were recorded at intake
+	put := func(txid string, r *oip5Record, latest bool) {
+		raw, err := proto.Marshal(r.Record)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s.Put(datastore.Index(o5RecordIndexName), txid, map[string]interface{}{
+			"record": map[string]interface{}{},
+			"meta":   map[string]interface{}{"txid": txid, "original": r.Meta.Txid, "latest": latest, "record_raw": base64.StdEncoding.EncodeToString(raw)},
+		})
+	}
+	artist := testRecord(artistTxid, detail(t, artistTemplateTxid, map[string]interface{}{"name": "Ryan"}))
+	album := testRecord(albumTxid, detail(t, albumTemplateTxid, map[string]interface{}{"title": "Songs", "artist": pb_oip.TxidFromString(artistTxid)}))
+	put(artistTxid, artist, true)
+	put(albumTxid, album, true)
+	// a superseded revision of the album naming another artist
+	superseded := testRecord(albumTxid, detail(t, albumTemplateTxid, map[string]interface{}{"title": "Songs", "artist": pb_oip.TxidFromString(secondAlbumTxid)}))
+	put("f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0f0", superseded, false)
+
+	err := backfillRecords(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, r := range []*oip5Record{artist, album} {
+		b, _ := s.Get(datastore.Index(o5RecordIndexName), r.Meta.Txid)
+		var el elasticOip5Record
+		if err := json.Unmarshal(b, &el); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(el.Meta.Templates, r.Meta.Templates) || el.Meta.RecordRaw == "" {
+			t.Errorf("backfilled record %s", b)
+		}
+	}
+
+	refId := albumTxid + "-tmpl_C0FFEE02-artist-0"
+	if ids := s.IDs(datastore.Index(referenceIndex)); !reflect.DeepEqual(ids, []string{refId}) {
+		t.Fatalf("reference ids %v", ids)
+	}
+	b, _ := s.Get(datastore.Index(referenceIndex), refId)
+	var ref elasticOip5Reference
+	if err := json.Unmarshal(b, &ref); err != nil {
+		t.Fatal(err)
+	}
+	if ref.Reference.Target != artistTxid || ref.Meta.Original != albumTxid {
+		t.Errorf("backfilled reference %s", b)
+	}
+}
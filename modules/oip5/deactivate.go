@@ -0,0 +1,225 @@
+package oip5
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+
+	"github.com/azer/logger"
+	"github.com/oipwg/proto/go/pb_oip5"
+	"github.com/spf13/viper"
+	"gopkg.in/olivere/elastic.v6"
+
+	"github.com/oipwg/oip/datastore"
+	"github.com/oipwg/oip/httpapi"
+	"github.com/oipwg/oip/modules/module"
+)
+
+const deactivateIndex = "oip5_deactivate"
+
+// deactivateTemplate is the published template, configured by txid, which,
+// attached as the only detail of a record, deactivates the record it references:
+//
+//	message P { oipProto.Txid reference = 1; string reason = 2; }
+var deactivateTemplate = &actionTemplate{action: "deactivation", key: "deactivateTemplate", fields: []string{"reason"}}
+
+var deactivateCommitMutex sync.Mutex
+
+func initDeactivate(hooks *module.Hooks, cfg *viper.Viper) {
+	deactivateTemplate.configure(cfg)
+	hooks.Subscribe("datastore:commit", onDatastoreCommitDeactivations)
+	httpapi.RegisterTraceReference(deactivateIndex, "deactivate.reference")
+}
+
+type elasticOip5Deactivate struct {
+	Deactivate struct {
+		Reference string `json:"reference"`
+		Reason    string `json:"reason"`
+	} `json:"deactivate"`
+	Meta DMeta `json:"meta"`
+}
+
+type DMeta struct {
+	Block     int64                      `json:"block"`
+	BlockHash string                     `json:"block_hash"`
+	Applied   bool                       `json:"applied"`
+	Invalid   bool                       `json:"invalid"`
+	SignedBy  string                     `json:"signed_by"`
+	Time      int64                      `json:"time"`
+	Tx        *datastore.TransactionData `json:"-"`
+	Txid      string                     `json:"txid"`
+}
+
+func intakeDeactivate(r *pb_oip5.RecordProto, pubKey []byte, tx *datastore.TransactionData) (*elastic.BulkIndexRequest, error) {
+	dm, ref, err := deactivateTemplate.decode(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var el elasticOip5Deactivate
+	el.Deactivate.Reference = ref
+	el.Deactivate.Reason = dm.GetFieldByName("reason").(string)
+	el.Meta = DMeta{
+		Block:     tx.Block,
+		BlockHash: tx.BlockHash,
+		Applied:   false,
+		Invalid:   false,
+		SignedBy:  string(pubKey),
+		Time:      tx.Transaction.Time,
+		Tx:        tx,
+		Txid:      tx.Transaction.Txid,
+	}
+
+	bir := elastic.NewBulkIndexRequest().
+		Index(datastore.Index(deactivateIndex)).
+		Type("_doc").
+		Id(tx.Transaction.Txid).
+		Doc(el)
+
+	return bir, nil
+}
+
+// onDatastoreCommitDeactivations applies pending deactivations, those whose
+// record is not yet known remain pending until it is
+func onDatastoreCommitDeactivations() {
+	deactivateCommitMutex.Lock()
+	defer deactivateCommitMutex.Unlock()
+
+	q := elastic.NewBoolQuery().Must(
+		elastic.NewTermQuery("meta.applied", false),
+		elastic.NewTermQuery("meta.invalid", false),
+	)
+	res, err := datastore.Client().
+		Search(datastore.Index(deactivateIndex)).
+		Type("_doc").
+		Query(q).
+		Size(10000).
+		Sort("meta.time", true).
+		Do(context.TODO())
+	if err != nil {
+		log.Error("elastic search failed", logger.Attrs{"err": err})
+		return
+	}
+
+	for _, v := range res.Hits.Hits {
+		var d elasticOip5Deactivate
+		err := json.Unmarshal(*v.Source, &d)
+		if err != nil {
+			log.Info("failed to unmarshal elastic hit", logger.Attrs{"err": err})
+			continue
+		}
+		attr := logger.Attrs{"reference": d.Deactivate.Reference, "txid": d.Meta.Txid}
+
+		rec, err := deactivationTarget(d.Deactivate.Reference)
+		if err == errNoDeactivationTarget {
+			log.Error("deactivation target is not a record", attr)
+			markDeactivationInvalid(d.Meta.Txid)
+			continue
+		}
+		if err != nil {
+			attr["err"] = err
+			log.Info("deactivation target not yet available", attr)
+			continue
+		}
+
+		if recordOwner(rec) != d.Meta.SignedBy {
+			log.Error("deactivation not signed by record owner", attr)
+			markDeactivationInvalid(d.Meta.Txid)
+			continue
+		}
+
+		err = deactivateRecord(rec.Meta.Original)
+		if err != nil {
+			attr["err"] = err
+			log.Error("unable to deactivate record", attr)
+			continue
+		}
+		// cached revisions are reloaded deactivated rather than modified in place
+		recordCache.Remove(rec.Meta.Original)
+		for _, txid := range rec.Meta.History {
+			recordCache.Remove(txid)
+		}
+
+		bur := elastic.NewBulkUpdateRequest().
+			Index(datastore.Index(deactivateIndex)).
+			Type("_doc").
+			Id(d.Meta.Txid).
+			Doc(MetaApplied{Applied{true}})
+		datastore.AutoBulk.Add(bur)
+	}
+}
+
+func markDeactivationInvalid(txid string) {
+	bur := elastic.NewBulkUpdateRequest().
+		Index(datastore.Index(deactivateIndex)).
+		Type("_doc").
+		Id(txid).
+		Doc(MetaInvalid{Invalid{true}})
+	datastore.AutoBulk.Add(bur)
+}
+
+// errNoDeactivationTarget is returned for a reference which will never resolve
+// to a record: a transaction indexed without a record, or an invalid edit
+var errNoDeactivationTarget = errors.New("deactivation target is not a record")
+
+// deactivationTarget returns the latest revision of the record of which the
+// reference, an original or an edit txid, is a revision
+func deactivationTarget(reference string) (*oip5Record, error) {
+	rev, err := GetRecordRevision(reference)
+	if err == nil {
+		return GetRecord(rev.Meta.Original)
+	}
+
+	get, err := datastore.Client().Get().
+		Index(datastore.Index(editIndex)).
+		Type("_doc").
+		Id(reference).
+		Do(context.TODO())
+	if err == nil && get.Found {
+		var edit elasticOip5Edit
+		err = json.Unmarshal(*get.Source, &edit)
+		if err != nil {
+			return nil, err
+		}
+		if edit.Meta.Invalid {
+			return nil, errNoDeactivationTarget
+		}
+		return nil, errors.New("edit not yet applied")
+	}
+	if err != nil && !elastic.IsNotFound(err) {
+		return nil, err
+	}
+
+	_, err = datastore.GetTransactionFromID(context.TODO(), reference)
+	if err == nil {
+		return nil, errNoDeactivationTarget
+	}
+	if err == datastore.ErrNotFound {
+		return nil, errors.New("record not yet indexed")
+	}
+	return nil, err
+}
+
+// deactivateRecord flags every revision of a record, its normalized views and
+// its references as deactivated
+func deactivateRecord(original string) error {
+	s := elastic.NewScript("ctx._source.meta.deactivated=true;").
+		Type("inline").
+		Lang("painless")
+
+	for _, index := range []string{o5RecordIndexName, normalizedIndex, referenceIndex} {
+		res, err := datastore.Client().
+			UpdateByQuery(datastore.Index(index)).
+			Type("_doc").
+			Query(elastic.NewTermQuery("meta.original", original)).
+			Script(s).
+			Refresh("true").
+			Do(context.TODO())
+		if err != nil {
+			return err
+		}
+		log.Info("deactivated", logger.Attrs{"index": index, "original": original, "updated": res.Updated})
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+package oip5
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/bitspill/flod/flojson"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/oipwg/proto/go/pb_oip"
+	"github.com/oipwg/proto/go/pb_oip5"
+	"github.com/spf13/viper"
+
+	"github.com/oipwg/oip/datastore"
+	"github.com/oipwg/oip/datastore/datastoretest"
+	"github.com/oipwg/oip/trace"
+)
+
+const deactivateTemplateTxid = "dea0715000000000000000000000000000000000000000000000000000000000"
+
+// configureAction points a to txid as an operator would
+func configureAction(a *actionTemplate, txid string) {
+	cfg := viper.New()
+	cfg.Set(a.key, txid)
+	a.configure(cfg)
+}
+
+// useDeactivateTemplate publishes a deactivation template and configures it
+func useDeactivateTemplate(t *testing.T) {
+	t.Helper()
+	reference := field("reference", 1, descriptor.FieldDescriptorProto_LABEL_OPTIONAL, descriptor.FieldDescriptorProto_TYPE_MESSAGE)
+	reference.TypeName = proto.String(".oipProto.templates.P.Txid")
+	registerTemplate(t, deactivateTemplateTxid, 0xdea07150,
+		reference,
+		field("reason", 2, descriptor.FieldDescriptorProto_LABEL_OPTIONAL, descriptor.FieldDescriptorProto_TYPE_STRING))
+	configureAction(deactivateTemplate, deactivateTemplateTxid)
+}
+
+func deactivation(t *testing.T, reference string, details ...*any.Any) *pb_oip5.RecordProto {
+	t.Helper()
+	d := detail(t, deactivateTemplateTxid, map[string]interface{}{
+		"reference": pb_oip.TxidFromString(reference),
+		"reason":    "published in error",
+	})
+	return &pb_oip5.RecordProto{Details: &pb_oip5.OipDetails{Details: append([]*any.Any{d}, details...)}}
+}
+
+func TestIntakeDeactivate(t *testing.T) {
+	const target = "e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5"
+	tx := &datastore.TransactionData{Transaction: &flojson.TxRawResult{Txid: "txid"}, Trace: trace.New("txid")}
+	useDeactivateTemplate(t)
+	defer configureAction(deactivateTemplate, "")
+
+	r := deactivation(t, target)
+	if !deactivateTemplate.of(r) {
+		t.Fatal("deactivation not recognized")
+	}
+	if _, err := intakeDeactivate(r, []byte("FOwner"), tx); err != nil {
+		t.Error(err)
+	}
+
+	mixed := deactivation(t, target, &any.Any{TypeUrl: "type.googleapis.com/oipProto.templates.tmpl_433C2783"})
+	if _, err := intakeDeactivate(mixed, []byte("FOwner"), tx); err == nil {
+		t.Error("accepted deactivation alongside other details")
+	}
+
+	if deactivateTemplate.of(&pb_oip5.RecordProto{Details: &pb_oip5.OipDetails{Details: []*any.Any{{TypeUrl: "type.googleapis.com/oipProto.templates.tmpl_433C2783"}}}}) {
+		t.Error("record recognized as deactivation")
+	}
+
+	// a template configured by txid is not confused with the one holding its identifier
+	configureAction(deactivateTemplate, "dea07150"+strings.Repeat("f", 56))
+	if deactivateTemplate.of(r) {
+		t.Error("record of another template with the same identifier recognized as deactivation")
+	}
+	if _, err := intakeDeactivate(r, []byte("FOwner"), tx); err == nil {
+		t.Error("decoded deactivation with another template")
+	}
+
+	configureAction(deactivateTemplate, "")
+	if deactivateTemplate.of(r) {
+		t.Error("deactivation recognized without a configured template")
+	}
+}
+
+func TestOnDatastoreCommitDeactivations(t *testing.T) {
+	const owned = "f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1f1"
+	const late = "f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2f2"
+	const edited = "f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3"
+	const unrecorded = "f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4f4"
+
+	s := datastoretest.NewServer()
+	defer s.Close()
+	datastore.SetClient(s.Client())
+
+	putRecord := func(txid, original string, latest bool) {
+		s.Put(datastore.Index(o5RecordIndexName), txid, map[string]interface{}{
+			"meta": map[string]interface{}{"txid": txid, "original": original, "latest": latest, "deactivated": false, "signed_by": "FOwner"},
+		})
+	}
+	putDeactivate := func(txid, reference, signer string) {
+		var el elasticOip5Deactivate
+		el.Deactivate.Reference = reference
+		el.Meta = DMeta{Txid: txid, SignedBy: signer, Time: 100}
+		s.Put(datastore.Index(deactivateIndex), txid, el)
+	}
+	deactivateMeta := func(txid string) DMeta {
+		b, _ := s.Get(datastore.Index(deactivateIndex), txid)
+		var el elasticOip5Deactivate
+		if err := json.Unmarshal(b, &el); err != nil {
+			t.Fatal(err)
+		}
+		return el.Meta
+	}
+	deactivated := func(txid string) bool {
+		b, _ := s.Get(datastore.Index(o5RecordIndexName), txid)
+		var el elasticOip5Record
+		if err := json.Unmarshal(b, &el); err != nil {
+			t.Fatal(err)
+		}
+		return el.Meta.Deactivated
+	}
+
+	putRecord(owned, owned, false)
+	putRecord("f1edit", owned, true)
+	putDeactivate("d-owner", owned, "FOwner")
+	putDeactivate("d-other", owned, "FOther")
+	putDeactivate("d-late", late, "FOwner")
+	// a deactivation may name any revision of the record
+	putRecord(edited, edited, false)
+	putRecord("f3edit", edited, true)
+	putDeactivate("d-revision", "f3edit", "FOwner")
+	// a transaction indexed without a record will never be one
+	s.Put(datastore.Index("transactions"), unrecorded, map[string]interface{}{"block": 1})
+	putDeactivate("d-unrecorded", unrecorded, "FOwner")
+
+	recordCache.Add(owned, &oip5Record{Meta: RMeta{Txid: "f1edit", Original: owned, History: []string{owned, "f1edit"}, SignedBy: "FOwner", Latest: true}})
+	defer recordCache.Purge()
+
+	onDatastoreCommitDeactivations()
+	datastore.AutoBulk.Commit()
+
+	if !deactivated(owned) || !deactivated("f1edit") {
+		t.Error("not every revision was deactivated")
+	}
+	if recordCache.Contains(owned) || recordCache.Contains("f1edit") {
+		t.Error("deactivated record left cached")
+	}
+	if !deactivated(edited) || !deactivated("f3edit") || !deactivateMeta("d-revision").Applied {
+		t.Error("deactivation of an edit revision not applied to the record")
+	}
+	if m := deactivateMeta("d-unrecorded"); m.Applied || !m.Invalid {
+		t.Errorf("deactivation of a transaction without a record applied %v invalid %v", m.Applied, m.Invalid)
+	}
+	if m := deactivateMeta("d-owner"); !m.Applied || m.Invalid {
+		t.Errorf("owner deactivation applied %v invalid %v", m.Applied, m.Invalid)
+	}
+	if m := deactivateMeta("d-other"); m.Applied || !m.Invalid {
+		t.Errorf("foreign deactivation applied %v invalid %v", m.Applied, m.Invalid)
+	}
+	if m := deactivateMeta("d-late"); m.Applied || m.Invalid {
+		t.Errorf("deactivation of an unknown record applied %v invalid %v", m.Applied, m.Invalid)
+	}
+
+	putRecord(late, late, true)
+	onDatastoreCommitDeactivations()
+	datastore.AutoBulk.Commit()
+
+	if !deactivated(late) || !deactivateMeta("d-late").Applied {
+		t.Error("pending deactivation not applied once its record arrived")
+	}
+}
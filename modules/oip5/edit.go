@@ -19,7 +19,8 @@ import (
 	"gopkg.in/olivere/elastic.v6"
 
 	"github.com/oipwg/oip/datastore"
-	"github.com/oipwg/oip/events"
+	"github.com/oipwg/oip/httpapi"
+	"github.com/oipwg/oip/modules/module"
 	"github.com/oipwg/oip/modules/oip5/templates"
 )
 
@@ -28,9 +29,9 @@ var editCommitMutex sync.Mutex
 
 const registeredPublisherTypeUrl = "type.googleapis.com/oipProto.templates.tmpl_433C2783"
 
-func init() {
-	events.SubscribeAsync("datastore:commit", onDatastoreCommitEdits)
-	datastore.RegisterMapping("oip5_edit", "oip5_edit.json")
+func initEdit(hooks *module.Hooks) {
+	hooks.Subscribe("datastore:commit", onDatastoreCommitEdits)
+	httpapi.RegisterTraceReference(editIndex, "reference")
 }
 
 func intakeEdit(n *pb_oip5.EditProto, pubKey []byte, tx *datastore.TransactionData) (*elastic.BulkIndexRequest, error) {
@@ -111,6 +112,7 @@ type EMeta struct {
 	Time      int64                      `json:"time"`
 	Tx        *datastore.TransactionData `json:"-"`
 	Txid      string                     `json:"txid"`
+	Reasons   []string                   `json:"reasons,omitempty"`
 }
 
 func onDatastoreCommitEdits() {
@@ -135,6 +137,8 @@ moreEdits:
 
 		if len(edit.TemplateRaw) != 0 {
 			editTemplate(edit)
+		} else if n := getNormalizer(edit.Reference); n != nil {
+			editNormalizer(n, edit)
 		} else {
 			editRecord(edit)
 		}
@@ -143,7 +147,8 @@ moreEdits:
 
 func editTemplate(edit elasticOip5Edit) {
 	tmpl, err := templates.GetTemplate(edit.Reference)
-	if err != nil {
+	// a matching identifier alone may be a template rejected for colliding
+	if err != nil || tmpl == nil || tmpl.Txid != edit.Reference {
 		markEditInvalid(edit.Meta.Txid)
 		log.Error("unable to obtain template for edit", logger.Attrs{"err": err, "reference": edit.Reference, "txid": edit.Meta.Txid})
 		return
@@ -155,11 +160,11 @@ func editTemplate(edit elasticOip5Edit) {
 		return
 	}
 
-	// ToDo validate changes are backwards compatible
-	// ToDo rename field?
-
 	err = templates.EditTemplate(tmpl, edit.TemplateRaw, edit.Meta.Txid)
-	if err != nil {
+	if ie, ok := err.(*templates.IncompatibleError); ok {
+		markEditRejected(edit.Meta.Txid, ie.Reasons)
+		log.Error("incompatible template edit", logger.Attrs{"reasons": ie.Reasons, "reference": edit.Reference, "txid": edit.Meta.Txid})
+	} else if err != nil {
 		markEditInvalid(edit.Meta.Txid)
 		log.Error("unable to edit template", logger.Attrs{"err": err, "reference": edit.Reference, "txid": edit.Meta.Txid})
 	}
@@ -173,7 +178,7 @@ func editRecord(edit elasticOip5Edit) {
 		return
 	}
 
-	if rec.Meta.SignedBy != edit.Meta.SignedBy {
+	if recordOwner(rec) != edit.Meta.SignedBy {
 		log.Error("edit not signed by record owner", logger.Attrs{"reference": edit.Reference, "txid": edit.Meta.Txid})
 		markEditInvalid(edit.Meta.Txid)
 		return
@@ -215,6 +220,13 @@ func editRecord(edit elasticOip5Edit) {
 		return
 	}
 
+	v := validateRecord(newRec)
+	if v != nil && v.Status == validationInvalid && recordValidation == validationReject {
+		markEditRejected(edit.Meta.Txid, v.Problems)
+		log.Error("edit results in an invalid record", logger.Attrs{"problems": v.Problems, "reference": edit.Reference, "txid": edit.Meta.Txid})
+		return
+	}
+
 	// Check to see if a publisher name was edited
 	regPubNameChanged := false
 	for i := range newRec.Details.Details {
@@ -234,7 +246,10 @@ func editRecord(edit elasticOip5Edit) {
 		}
 	}
 
+	prev := rec.Record
 	rec.Record = newRec
+	rec.Meta.Templates = recordTemplateNames(newRec)
+	rec.Meta.Validation = v
 
 	rec.Meta.History = append(rec.Meta.History, edit.Meta.Txid)
 	rec.Meta.LastModified = edit.Meta.Time
@@ -293,6 +308,9 @@ func editRecord(edit elasticOip5Edit) {
 
 	datastore.AutoBulk.Add(bur)
 
+	normalizeRecord(rec, nil)
+	indexReferences(rec, prev, nil)
+
 	if regPubNameChanged {
 		datastore.AutoBulk.Commit()
 		err := updatePublisherName(rec.Meta.SignedBy, rec.Meta.PublisherName)
@@ -338,6 +356,17 @@ func markEditInvalid(txid string) {
 	datastore.AutoBulk.Add(bur)
 }
 
+// markEditRejected marks an edit invalid, recording why in meta.reasons
+func markEditRejected(txid string, reasons []string) {
+	bur := elastic.NewBulkUpdateRequest().
+		Index(datastore.Index(editIndex)).
+		Type("_doc").
+		Id(txid).
+		Doc(MetaRejected{Rejected{Invalid: true, Reasons: reasons}})
+
+	datastore.AutoBulk.Add(bur)
+}
+
 func queryEdits(edits []elasticOip5Edit, after []interface{}) ([]elasticOip5Edit, []interface{}, error) {
 	var nextAfter []interface{}
 	searchSize := 10000
@@ -402,3 +431,11 @@ type Applied struct {
 type MetaApplied struct {
 	Meta Applied `json:"meta"`
 }
+
+type Rejected struct {
+	Invalid bool     `json:"invalid"`
+	Reasons []string `json:"reasons"`
+}
+type MetaRejected struct {
+	Meta Rejected `json:"meta"`
+}
@@ -0,0 +1,86 @@
+package oip5
+
+import (
+	"encoding/base64"
+	"reflect"
+	"testing"
+
+	"github.com/bitspill/flod/flojson"
+	patch "github.com/bitspill/protoPatch"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/oipwg/proto/go/pb_oip5"
+
+	"github.com/oipwg/oip/datastore"
+	"github.com/oipwg/oip/datastore/datastoretest"
+	"github.com/oipwg/oip/trace"
+)
+
+func TestTraceEditedRecord(t *testing.T) {
+	const original = "ed17ed17ed17ed17ed17ed17ed17ed17ed17ed17ed17ed17ed17ed17ed17ed17"
+
+	s := datastoretest.NewServer()
+	defer s.Close()
+	datastore.SetClient(s.Client())
+	registerTestTemplates(t)
+	defer recordCache.Remove(original)
+
+	artist := func(name string) *pb_oip5.RecordProto {
+		d := detail(t, artistTemplateTxid, map[string]interface{}{"name": name})
+		return &pb_oip5.RecordProto{Details: &pb_oip5.OipDetails{Details: []*any.Any{d}}}
+	}
+	// edit replaces the details of the original with those of an artist named name
+	edit := func(txid, name string) {
+		pp, err := patch.ToProto(&patch.Patch{
+			NewValues: artist(name),
+			Ops: []patch.Op{{
+				Path: []patch.Step{
+					{Tag: 7, Action: patch.ActionStepInto, SrcIndex: 1, DstIndex: 1}, // details
+					{Tag: 1, Action: patch.ActionReplace},
+				},
+			}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := proto.Marshal(pp.(*patch.ProtoPatch))
+		if err != nil {
+			t.Fatal(err)
+		}
+		editRecord(elasticOip5Edit{
+			PatchRaw:  base64.StdEncoding.EncodeToString(b),
+			Reference: original,
+			Meta:      EMeta{SignedBy: "FOwner", Time: 100, Txid: txid},
+		})
+	}
+
+	r := artist("first")
+	bir, _, err := intakeRecord(r, []byte("FOwner"), &datastore.TransactionData{Transaction: &flojson.TxRawResult{Txid: original}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	datastore.AutoBulk.Add(bir)
+	edit("e1", "second")
+
+	traced := &datastore.TransactionData{Transaction: &flojson.TxRawResult{Txid: original}, Trace: trace.New(original)}
+	_, traceRec, err := intakeRecord(r, []byte("FOwner"), traced)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(traceRec.Meta.History, []string{original}) {
+		t.Errorf("traced record history %v", traceRec.Meta.History)
+	}
+
+	// the edit applies upon the previous edit rather than the traced record
+	edit("e2", "third")
+	rec, err := GetRecord(original)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{original, "e1", "e2"}; !reflect.DeepEqual(rec.Meta.History, expected) {
+		t.Errorf("history %v, expected %v", rec.Meta.History, expected)
+	}
+	if !proto.Equal(rec.Record, artist("third")) {
+		t.Errorf("record %v, expected the third artist", rec.Record)
+	}
+}
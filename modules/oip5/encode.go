@@ -0,0 +1,131 @@
+package oip5
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/oipwg/proto/go/pb_oip"
+	"github.com/oipwg/proto/go/pb_oip5"
+
+	"github.com/oipwg/oip/modules/oip5/templates"
+	"github.com/oipwg/oip/publishing"
+)
+
+const maxEncodeBodySize = 256 * 1024
+
+// sizes assumed for the signature and address of a record yet to be signed,
+// a flo compact signature and a p2pkh address
+const (
+	encodeSignatureSize = 65
+	encodeAddressSize   = 34
+)
+
+// EncodedRecord is an unsigned record ready to be signed and published
+type EncodedRecord struct {
+	// hex of the serialized OipFive message
+	Serialized string `json:"serialized"`
+	// base64 of the serialized message, the text to sign
+	Preimage string `json:"preimage"`
+	// expected length of the p64: floData once signed
+	FloDataSize int `json:"flo_data_size"`
+	// expected number of transactions, more than one for a multipart
+	PartCount  int         `json:"part_count"`
+	Validation *Validation `json:"validation,omitempty"`
+}
+
+// decodeRecordJson builds a record from the json form served by the api, with
+// details keyed by template name and encoded through the template message factory
+func decodeRecordJson(b []byte) (*pb_oip5.RecordProto, error) {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(b, &fields)
+	if err != nil {
+		return nil, err
+	}
+
+	var details map[string]json.RawMessage
+	if raw, ok := fields["details"]; ok {
+		err = json.Unmarshal(raw, &details)
+		if err != nil {
+			return nil, fmt.Errorf("details must be an object keyed by template: %s", err)
+		}
+		delete(fields, "details")
+	}
+
+	rest, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	r := &pb_oip5.RecordProto{}
+	err = jsonpb.Unmarshal(bytes.NewReader(rest), r)
+	if err != nil {
+		return nil, err
+	}
+
+	// sorted so that a record always encodes to the same bytes
+	names := make([]string, 0, len(details))
+	for name := range details {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	r.Details = &pb_oip5.OipDetails{}
+	for _, name := range names {
+		if !isTemplateName(name) {
+			return nil, fmt.Errorf("detail %q is not a template name", name)
+		}
+		typeName := "oipProto.templates.tmpl_" + strings.ToUpper(name[5:])
+		m, err := templates.CreateNewMessage(typeName)
+		if err != nil {
+			return nil, fmt.Errorf("unknown template %s", name)
+		}
+		err = jsonpb.Unmarshal(bytes.NewReader(details[name]), m)
+		if err != nil {
+			return nil, fmt.Errorf("detail %s: %s", name, err)
+		}
+		value, err := proto.Marshal(m)
+		if err != nil {
+			return nil, fmt.Errorf("detail %s: %s", name, err)
+		}
+		r.Details.Details = append(r.Details.Details, &any.Any{TypeUrl: "type.googleapis.com/" + typeName, Value: value})
+	}
+	return r, nil
+}
+
+// encodeRecord serializes r for signing by address, sizes assume a p2pkh
+// address when none is given
+func encodeRecord(r *pb_oip5.RecordProto, address string) (*EncodedRecord, error) {
+	serialized, err := proto.Marshal(&pb_oip5.OipFive{Record: r})
+	if err != nil {
+		return nil, err
+	}
+
+	if address == "" {
+		address = strings.Repeat("F", encodeAddressSize)
+	}
+	signed, err := proto.Marshal(&pb_oip.SignedMessage{
+		SerializedMessage: serialized,
+		MessageType:       pb_oip.MessageTypes_OIP05,
+		SignatureType:     pb_oip.SignatureTypes_Flo,
+		PubKey:            []byte(address),
+		Signature:         make([]byte, encodeSignatureSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	floDataSize := len("p64:") + base64.StdEncoding.EncodedLen(len(signed))
+	return &EncodedRecord{
+		Serialized:  hex.EncodeToString(serialized),
+		Preimage:    base64.StdEncoding.EncodeToString(serialized),
+		FloDataSize: floDataSize,
+		PartCount:   publishing.PartCount(floDataSize),
+	}, nil
+}
@@ -0,0 +1,90 @@
+package oip5
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/oipwg/proto/go/pb_oip5"
+)
+
+func TestDecodeRecordJson(t *testing.T) {
+	registerTestTemplates(t)
+
+	cases := []struct {
+		name  string
+		json  string
+		valid bool
+	}{
+		{"details", `{"details": {"tmpl_C0FFEE02": {"title": "Songs", "tracks": ["One", "Two"]}, "tmpl_c0ffee01": {"name": "Ryan"}}}`, true},
+		{"no details", `{}`, true},
+		{"unknown template", `{"details": {"tmpl_0BADF00D": {"title": "Songs"}}}`, false},
+		{"not a template", `{"details": {"title": "Songs"}}`, false},
+		{"unknown field", `{"details": {"tmpl_C0FFEE02": {"name": "Songs"}}}`, false},
+		{"unknown record field", `{"colour": "red"}`, false},
+		{"details not an object", `{"details": ["tmpl_C0FFEE02"]}`, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := decodeRecordJson([]byte(c.json))
+			if (err == nil) != c.valid {
+				t.Errorf("decodeRecordJson() = %v, want valid %v", err, c.valid)
+			}
+		})
+	}
+
+	r, err := decodeRecordJson([]byte(`{"details": {"tmpl_C0FFEE02": {"title": "Songs"}, "tmpl_C0FFEE01": {"name": "Ryan"}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(recordTemplateNames(r), ","); got != "tmpl_C0FFEE01,tmpl_C0FFEE02" {
+		t.Errorf("templates = %s", got)
+	}
+	if dm := recordDetail(r, albumTemplate); dm == nil || dm.GetFieldByName("title") != "Songs" {
+		t.Errorf("album detail = %v", dm)
+	}
+}
+
+func TestEncodeRecord(t *testing.T) {
+	registerTestTemplates(t)
+
+	r := &pb_oip5.RecordProto{Details: &pb_oip5.OipDetails{Details: []*any.Any{
+		detail(t, albumTemplateTxid, map[string]interface{}{"title": "Songs"}),
+	}}}
+	enc, err := encodeRecord(r, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serialized, err := hex.DecodeString(enc.Serialized)
+	if err != nil {
+		t.Fatal(err)
+	}
+	preimage, err := base64.StdEncoding.DecodeString(enc.Preimage)
+	if err != nil || !bytes.Equal(preimage, serialized) {
+		t.Errorf("preimage does not match serialized message")
+	}
+	o5 := &pb_oip5.OipFive{}
+	err = proto.Unmarshal(serialized, o5)
+	if err != nil || !proto.Equal(o5.Record, r) {
+		t.Errorf("serialized message does not decode to the record")
+	}
+	if enc.PartCount != 1 || enc.FloDataSize <= len(enc.Preimage) {
+		t.Errorf("flo data size %d in %d parts", enc.FloDataSize, enc.PartCount)
+	}
+
+	large := &pb_oip5.RecordProto{Details: &pb_oip5.OipDetails{Details: []*any.Any{
+		detail(t, albumTemplateTxid, map[string]interface{}{"title": strings.Repeat("Songs", 400)}),
+	}}}
+	enc, err = encodeRecord(large, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enc.PartCount < 2 {
+		t.Errorf("%d bytes of flo data in %d parts", enc.FloDataSize, enc.PartCount)
+	}
+}
@@ -0,0 +1,51 @@
+//go:build go1.18
+// +build go1.18
+
+package oip5
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"testing"
+
+	"github.com/bitspill/flod/flojson"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/oipwg/proto/go/pb_oip5"
+
+	"github.com/oipwg/oip/datastore"
+	"github.com/oipwg/oip/trace"
+)
+
+// OipFive message of the signed record decoded in TestDecodeRecord
+const o5Record = "EpQBOpEBCkMKNHR5cGUuZ29vZ2xlYXBpcy5jb20vb2lwUHJvdG8udGVtcGxhdGVzLnRtcGxfMkYyOUQ4QzASCwoDZmx5EgRlbW1hCkoKNHR5cGUuZ29vZ2xlYXBpcy5jb20vb2lwUHJvdG8udGVtcGxhdGVzLnRtcGxfNUQ4REI4NUISEgoEcnlsbxIFZWFydGgaA3JlZA=="
+
+// FuzzOip5Message exercises the decoding of oip5 messages up to, but not
+// including, the steps which require elasticsearch
+func FuzzOip5Message(f *testing.F) {
+	b, err := base64.StdEncoding.DecodeString(o5Record)
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(b)
+	f.Fuzz(func(t *testing.T, b []byte) {
+		o5 := &pb_oip5.OipFive{}
+		if err := proto.Unmarshal(b, o5); err != nil {
+			return
+		}
+		tx := &datastore.TransactionData{Transaction: &flojson.TxRawResult{Txid: "txid"}, Trace: trace.New("txid")}
+
+		if o5.Record != nil {
+			var m jsonpb.Marshaler
+			_ = m.Marshal(ioutil.Discard, o5.Record)
+			_ = recordTemplateNames(o5.Record)
+			_ = registeredPublisherName(o5.Record, tx)
+			if deactivateTemplate.of(o5.Record) {
+				_, _ = intakeDeactivate(o5.Record, nil, tx)
+			}
+		}
+		if o5.Edit != nil {
+			_, _ = intakeEdit(o5.Edit, nil, tx)
+		}
+	})
+}
@@ -0,0 +1,294 @@
+package oip5
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/dynamic"
+	"github.com/oipwg/proto/go/pb_oip5"
+	"gopkg.in/olivere/elastic.v6"
+
+	"github.com/oipwg/oip/datastore"
+)
+
+// maxRevisions bounds the number of revisions of a record returned
+const maxRevisions = 10000
+
+// maxDiffDepth bounds the nesting of messages compared field by field, deeper
+// messages are compared as a whole
+const maxDiffDepth = 8
+
+var errRecordNotFound = errors.New("record not found")
+
+// Revision is a version of a record and the transaction which produced it,
+// either the record itself or one of its edits
+type Revision struct {
+	Txid      string          `json:"txid"`
+	Edit      bool            `json:"edit"`
+	Block     int64           `json:"block"`
+	BlockHash string          `json:"block_hash"`
+	Time      int64           `json:"time"`
+	SignedBy  string          `json:"signed_by"`
+	Latest    bool            `json:"latest"`
+	Record    json.RawMessage `json:"record,omitempty"`
+
+	recordRaw string
+}
+
+// decode returns the record as stored by this revision
+func (rev *Revision) decode() (*pb_oip5.RecordProto, error) {
+	raw, err := base64.StdEncoding.DecodeString(rev.recordRaw)
+	if err != nil {
+		return nil, err
+	}
+	r := &pb_oip5.RecordProto{}
+	err = proto.Unmarshal(raw, r)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// recordRevisions returns every indexed revision of original in the order the
+// edits were applied
+func recordRevisions(ctx context.Context, original string) ([]*Revision, error) {
+	res, err := datastore.Client().
+		Search(datastore.Index(o5RecordIndexName)).
+		Type("_doc").
+		Query(elastic.NewTermQuery("meta.original", original)).
+		Size(maxRevisions).
+		Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stored := make(map[string]elasticOip5Record, len(res.Hits.Hits))
+	var latest elasticOip5Record
+	for _, h := range res.Hits.Hits {
+		var el elasticOip5Record
+		err := json.Unmarshal(*h.Source, &el)
+		if err != nil {
+			return nil, err
+		}
+		stored[h.Id] = el
+		// the latest revision lists every edit applied
+		if len(el.Meta.History) > len(latest.Meta.History) {
+			latest = el
+		}
+	}
+	if len(stored) == 0 {
+		return nil, errRecordNotFound
+	}
+
+	edits := make(map[string]EMeta)
+	if len(latest.Meta.History) > 1 {
+		ids := latest.Meta.History[1:]
+		res, err := datastore.Client().
+			Search(datastore.Index(editIndex)).
+			Type("_doc").
+			Query(elastic.NewIdsQuery("_doc").Ids(ids...)).
+			Size(len(ids)).
+			Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, h := range res.Hits.Hits {
+			var el elasticOip5Edit
+			err := json.Unmarshal(*h.Source, &el)
+			if err != nil {
+				return nil, err
+			}
+			edits[h.Id] = el.Meta
+		}
+	}
+
+	var revs []*Revision
+	for i, txid := range latest.Meta.History {
+		el, ok := stored[txid]
+		if !ok {
+			continue
+		}
+		rev := &Revision{
+			Txid:      txid,
+			Edit:      i != 0,
+			Block:     el.Meta.Block,
+			BlockHash: el.Meta.BlockHash,
+			Time:      el.Meta.Time,
+			SignedBy:  el.Meta.SignedBy,
+			Latest:    el.Meta.Latest,
+			Record:    el.Record,
+			recordRaw: el.Meta.RecordRaw,
+		}
+		if rev.Edit {
+			rev.Time = el.Meta.LastModified
+			if e, ok := edits[txid]; ok {
+				rev.Block = e.Block
+				rev.BlockHash = e.BlockHash
+				rev.Time = e.Time
+				rev.SignedBy = e.SignedBy
+			}
+		}
+		revs = append(revs, rev)
+	}
+	return revs, nil
+}
+
+// revisionIndex returns the position of txid in revs, -1 if absent
+func revisionIndex(revs []*Revision, txid string) int {
+	for i, rev := range revs {
+		if rev.Txid == txid {
+			return i
+		}
+	}
+	return -1
+}
+
+const (
+	changeAdded   = "added"
+	changeRemoved = "removed"
+	changeChanged = "changed"
+)
+
+// FieldChange is a difference between two revisions of a record, Path is the
+// dotted path of the field with details named by their template
+type FieldChange struct {
+	Path string      `json:"path"`
+	Op   string      `json:"op"`
+	From interface{} `json:"from,omitempty"`
+	To   interface{} `json:"to,omitempty"`
+}
+
+// diffRecords lists the fields differing between two versions of a record,
+// prev may be nil to list every field of next
+func diffRecords(prev, next *pb_oip5.RecordProto) ([]FieldChange, error) {
+	if prev == nil {
+		prev = &pb_oip5.RecordProto{}
+	}
+	pdm, err := dynamic.AsDynamicMessage(prev)
+	if err != nil {
+		return nil, err
+	}
+	ndm, err := dynamic.AsDynamicMessage(next)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []FieldChange
+	for _, fd := range ndm.GetMessageDescriptor().GetFields() {
+		if fd.GetName() == "details" {
+			continue
+		}
+		changes = diffField(fd, pdm, ndm, "", 0, changes)
+	}
+	return diffDetails(prev.Details.GetDetails(), next.Details.GetDetails(), changes), nil
+}
+
+// diffDetails pairs details by template, repeated uses of a template by order
+func diffDetails(prev, next []*any.Any, changes []FieldChange) []FieldChange {
+	prevByName, prevOrder := detailsByName(prev)
+	nextByName, nextOrder := detailsByName(next)
+
+	for _, name := range prevOrder {
+		if _, ok := nextByName[name]; !ok {
+			changes = append(changes, FieldChange{Path: "details." + name, Op: changeRemoved, From: detailValue(prevByName[name])})
+		}
+	}
+	for _, name := range nextOrder {
+		nd := nextByName[name]
+		pd, ok := prevByName[name]
+		if !ok {
+			changes = append(changes, FieldChange{Path: "details." + name, Op: changeAdded, To: detailValue(nd)})
+			continue
+		}
+		if bytes.Equal(pd.Value, nd.Value) {
+			continue
+		}
+		pdm, perr := decodeDetail(pd)
+		ndm, nerr := decodeDetail(nd)
+		if perr != nil || nerr != nil {
+			// template unknown, only the fact that it changed can be reported
+			changes = append(changes, FieldChange{Path: "details." + name, Op: changeChanged})
+			continue
+		}
+		for _, fd := range ndm.GetMessageDescriptor().GetFields() {
+			changes = diffField(fd, pdm, ndm, "details."+name+".", 0, changes)
+		}
+	}
+	return changes
+}
+
+func detailsByName(details []*any.Any) (map[string]*any.Any, []string) {
+	byName := make(map[string]*any.Any, len(details))
+	var order []string
+	seen := make(map[string]int)
+	for _, d := range details {
+		name := d.TypeUrl[strings.LastIndex(d.TypeUrl, ".")+1:]
+		if n := seen[name]; n != 0 {
+			seen[name]++
+			name += "[" + strconv.Itoa(n) + "]"
+		} else {
+			seen[name] = 1
+		}
+		byName[name] = d
+		order = append(order, name)
+	}
+	return byName, order
+}
+
+// detailValue is the json form of a detail, nil if its template is unknown
+func detailValue(d *any.Any) interface{} {
+	dm, err := decodeDetail(d)
+	if err != nil {
+		return nil
+	}
+	b, err := dm.MarshalJSON()
+	if err != nil {
+		return nil
+	}
+	return json.RawMessage(b)
+}
+
+func diffField(fd *desc.FieldDescriptor, prev, next *dynamic.Message, path string, depth int, changes []FieldChange) []FieldChange {
+	name := path + fd.GetName()
+	ph, nh := prev.HasField(fd), next.HasField(fd)
+	switch {
+	case !ph && !nh:
+		return changes
+	case !nh:
+		return append(changes, FieldChange{Path: name, Op: changeRemoved, From: normalizedValue(fd, prev.GetField(fd))})
+	case !ph:
+		return append(changes, FieldChange{Path: name, Op: changeAdded, To: normalizedValue(fd, next.GetField(fd))})
+	}
+
+	pv, nv := prev.GetField(fd), next.GetField(fd)
+	if md := fd.GetMessageType(); md != nil && !fd.IsRepeated() && !fd.IsMap() && md.GetFullyQualifiedName() != txidMessageName && depth < maxDiffDepth {
+		pp, pok := pv.(proto.Message)
+		np, nok := nv.(proto.Message)
+		if pok && nok {
+			pm, perr := dynamic.AsDynamicMessage(pp)
+			nm, nerr := dynamic.AsDynamicMessage(np)
+			if perr != nil || nerr != nil {
+				return append(changes, FieldChange{Path: name, Op: changeChanged})
+			}
+			for _, nested := range md.GetFields() {
+				changes = diffField(nested, pm, nm, name+".", depth+1, changes)
+			}
+			return changes
+		}
+	}
+
+	from, to := normalizedValue(fd, pv), normalizedValue(fd, nv)
+	if reflect.DeepEqual(from, to) {
+		return changes
+	}
+	return append(changes, FieldChange{Path: name, Op: changeChanged, From: from, To: to})
+}
@@ -0,0 +1,134 @@
+package oip5
+
+import (
+	"context"
+	"encoding/base64"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/oipwg/proto/go/pb_oip"
+	"github.com/oipwg/proto/go/pb_oip5"
+
+	"github.com/oipwg/oip/datastore"
+	"github.com/oipwg/oip/datastore/datastoretest"
+)
+
+func TestDiffRecords(t *testing.T) {
+	registerTestTemplates(t)
+
+	record := func(details ...*any.Any) *pb_oip5.RecordProto {
+		return &pb_oip5.RecordProto{Details: &pb_oip5.OipDetails{Details: details}}
+	}
+	artist := detail(t, artistTemplateTxid, map[string]interface{}{"name": "Ryan"})
+	album := detail(t, albumTemplateTxid, map[string]interface{}{"title": "Songs", "tracks": []interface{}{"One"}})
+
+	cases := []struct {
+		name  string
+		prev  *pb_oip5.RecordProto
+		next  *pb_oip5.RecordProto
+		paths map[string]string
+	}{
+		{"unchanged", record(artist), record(artist), map[string]string{}},
+		{"created", nil, record(artist), map[string]string{"details.tmpl_C0FFEE01": changeAdded}},
+		{"detail removed", record(artist, album), record(artist), map[string]string{"details.tmpl_C0FFEE02": changeRemoved}},
+		{"fields changed", record(album), record(detail(t, albumTemplateTxid, map[string]interface{}{
+			"tracks": []interface{}{"One", "Two"},
+			"artist": pb_oip.TxidFromString(artistTxid),
+		})), map[string]string{
+			"details.tmpl_C0FFEE02.title":  changeRemoved,
+			"details.tmpl_C0FFEE02.artist": changeAdded,
+			"details.tmpl_C0FFEE02.tracks": changeChanged,
+		}},
+		{"unknown template", record(&any.Any{TypeUrl: "type.googleapis.com/oipProto.templates.tmpl_0BADF00D", Value: []byte{1}}),
+			record(&any.Any{TypeUrl: "type.googleapis.com/oipProto.templates.tmpl_0BADF00D", Value: []byte{2}}),
+			map[string]string{"details.tmpl_0BADF00D": changeChanged}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			changes, err := diffRecords(c.prev, c.next)
+			if err != nil {
+				t.Fatal(err)
+			}
+			paths := make(map[string]string)
+			for _, ch := range changes {
+				paths[ch.Path] = ch.Op
+			}
+			if !reflect.DeepEqual(paths, c.paths) {
+				t.Errorf("diffRecords() = %v, want %v", paths, c.paths)
+			}
+		})
+	}
+
+	changes, err := diffRecords(record(album), record(detail(t, albumTemplateTxid, map[string]interface{}{"title": "Tunes", "tracks": []interface{}{"One"}})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes[0].From != "Songs" || changes[0].To != "Tunes" {
+		t.Errorf("title change = %+v", changes)
+	}
+}
+
+func TestRecordRevisions(t *testing.T) {
+	const editTxid = "e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6"
+	registerTestTemplates(t)
+
+	s := datastoretest.NewServer()
+	defer s.Close()
+	datastore.SetClient(s.Client())
+
+	putRevision := func(id string, history []string, latest bool, d *any.Any) {
+		raw, err := proto.Marshal(&pb_oip5.RecordProto{Details: &pb_oip5.OipDetails{Details: []*any.Any{d}}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var el elasticOip5Record
+		el.Meta = RMeta{
+			Block:        1,
+			Time:         10,
+			SignedBy:     "FOwner",
+			Txid:         artistTxid,
+			Original:     artistTxid,
+			History:      history,
+			Latest:       latest,
+			LastModified: 10 * int64(len(history)),
+			RecordRaw:    base64.StdEncoding.EncodeToString(raw),
+		}
+		s.Put(datastore.Index(o5RecordIndexName), id, el)
+	}
+	putRevision(artistTxid, []string{artistTxid}, false, detail(t, artistTemplateTxid, map[string]interface{}{"name": "Ryan"}))
+	putRevision(editTxid, []string{artistTxid, editTxid}, true, detail(t, artistTemplateTxid, map[string]interface{}{"name": "Bryan"}))
+	s.Put(datastore.Index(editIndex), editTxid, elasticOip5Edit{Reference: artistTxid, Meta: EMeta{Block: 2, Time: 20, SignedBy: "FOwner", Txid: editTxid, Applied: true}})
+
+	if _, err := recordRevisions(context.Background(), albumTxid); err != errRecordNotFound {
+		t.Errorf("revisions of an unknown record err = %v", err)
+	}
+
+	revs, err := recordRevisions(context.Background(), artistTxid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(revs) != 2 || revs[0].Txid != artistTxid || revs[0].Edit || revs[1].Txid != editTxid || !revs[1].Edit || !revs[1].Latest {
+		t.Fatalf("revisions = %+v", revs)
+	}
+	if revs[0].Block != 1 || revs[1].Block != 2 || revs[1].Time != 20 {
+		t.Errorf("revision transactions = %+v, %+v", revs[0], revs[1])
+	}
+
+	prev, err := revs[0].decode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	next, err := revs[1].decode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	changes, err := diffRecords(prev, next)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes[0].Path != "details.tmpl_C0FFEE01.name" || changes[0].From != "Ryan" || changes[0].To != "Bryan" {
+		t.Errorf("revision changes = %+v", changes)
+	}
+}
@@ -0,0 +1,74 @@
+package oip5
+
+import (
+	"context"
+
+	"github.com/spf13/viper"
+
+	"github.com/oipwg/oip/modules/module"
+	"github.com/oipwg/oip/modules/oip5/templates"
+)
+
+type oip5 struct {
+	hooks module.Hooks
+}
+
+func init() {
+	module.Register(&oip5{})
+}
+
+func (o *oip5) Name() string {
+	return "oip5"
+}
+
+// Requires the oip module which decodes p64: prefixed floData
+func (o *oip5) Requires() []string {
+	return []string{"oip"}
+}
+
+func (o *oip5) Mappings() map[string]string {
+	return map[string]string{
+		o5TemplateIndexName: "oip5_templates.json",
+		o5RecordIndexName:   "oip5_record.json",
+		editIndex:           "oip5_edit.json",
+		normalizeIndex:      "oip5_normalize.json",
+		normalizedIndex:     "oip5_normalized.json",
+		deactivateIndex:     "oip5_deactivate.json",
+		transferIndex:       "oip5_transfer.json",
+		referenceIndex:      "oip5_reference.json",
+	}
+}
+
+func (o *oip5) Routes() {
+	registerRoutes()
+}
+
+func (o *oip5) Init(ctx context.Context, cfg *viper.Viper) error {
+	err := templates.LoadTemplatesFromES(ctx)
+	if err != nil {
+		return err
+	}
+	err = loadNormalizersFromES(ctx)
+	if err != nil {
+		return err
+	}
+	err = backfillRecords(ctx)
+	if err != nil {
+		return err
+	}
+
+	initRecord(cfg)
+	initValidation(&o.hooks, cfg)
+	initPublisher(&o.hooks, cfg)
+	initOip5(&o.hooks)
+	initEdit(&o.hooks)
+	initNormalize(&o.hooks)
+	initDeactivate(&o.hooks, cfg)
+	initTransfer(&o.hooks, cfg)
+	return nil
+}
+
+func (o *oip5) Shutdown(ctx context.Context) error {
+	o.hooks.Remove()
+	return nil
+}
@@ -0,0 +1,723 @@
+package oip5
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/azer/logger"
+	patch "github.com/bitspill/protoPatch"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/dynamic"
+	"github.com/oipwg/proto/go/pb_oip"
+	"github.com/oipwg/proto/go/pb_oip5"
+	"gopkg.in/olivere/elastic.v6"
+
+	"github.com/oipwg/oip/datastore"
+	"github.com/oipwg/oip/modules/module"
+	"github.com/oipwg/oip/modules/oip5/templates"
+)
+
+const normalizeIndex = "oip5_normalize"
+const normalizedIndex = "oip5_normalized"
+
+// maxNormalizePathDepth bounds the number of records a single normalized field may traverse
+const maxNormalizePathDepth = 8
+
+// maxNormalizerFields bounds the number of fields of a single normalizer
+const maxNormalizerFields = 64
+
+// normalizedFieldBudget bounds the fields applied normalizers may add to the
+// mapping of the normalized index, kept below its total_fields.limit of 10000
+// so that a normalizer beyond it is marked invalid rather than failing writes
+const normalizedFieldBudget = 9000
+
+var normalizeCommitMutex sync.Mutex
+var normalizerCacheMutex sync.RWMutex
+var normalizerCache = make(map[string]*normalizer)
+
+var normalizedFieldName = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+func initNormalize(hooks *module.Hooks) {
+	hooks.Subscribe("datastore:commit", onDatastoreCommitNormalizers)
+}
+
+// normalizer maps fields of records containing its main template into a flat,
+// named view stored in the oip5_normalized index
+type normalizer struct {
+	Normalizer *pb_oip5.NormalizeRecordProto
+	Meta       NMeta
+}
+
+type elasticOip5Normalizer struct {
+	Normalizer json.RawMessage `json:"normalizer"`
+	Meta       NMeta           `json:"meta"`
+}
+
+type NMeta struct {
+	Block         int64                      `json:"block"`
+	BlockHash     string                     `json:"block_hash"`
+	Applied       bool                       `json:"applied"`
+	Invalid       bool                       `json:"invalid"`
+	SignedBy      string                     `json:"signed_by"`
+	Time          int64                      `json:"time"`
+	Tx            *datastore.TransactionData `json:"-"`
+	Txid          string                     `json:"txid"`
+	Name          string                     `json:"name"`
+	MainTemplate  string                     `json:"main_template"`
+	History       []string                   `json:"history"`
+	LastModified  int64                      `json:"last_modified"`
+	NormalizerRaw string                     `json:"normalizer_raw"`
+}
+
+type elasticOip5Normalized struct {
+	// normalized fields keyed by the name of the normalizer, so that
+	// normalizers may reuse field names with different types
+	Normalized map[string]map[string]interface{} `json:"normalized"`
+	Meta       NormalizedMeta                    `json:"meta"`
+}
+
+type NormalizedMeta struct {
+	Normalizer    string `json:"normalizer"`
+	Name          string `json:"name"`
+	Original      string `json:"original"`
+	Txid          string `json:"txid"`
+	SignedBy      string `json:"signed_by"`
+	PublisherName string `json:"publisher_name"`
+	Deactivated   bool   `json:"deactivated"`
+	Time          int64  `json:"time"`
+	LastModified  int64  `json:"last_modified"`
+}
+
+func intakeNormalizer(n *pb_oip5.NormalizeRecordProto, pubKey []byte, tx *datastore.TransactionData) (*elastic.BulkIndexRequest, error) {
+	if len(tx.Transaction.Txid) < 8 {
+		return nil, errors.New("invalid txid")
+	}
+	err := validateNormalizer(n)
+	if err != nil {
+		return nil, err
+	}
+
+	var el elasticOip5Normalizer
+	el.Meta = NMeta{
+		Block:        tx.Block,
+		BlockHash:    tx.BlockHash,
+		Applied:      false,
+		Invalid:      false,
+		SignedBy:     string(pubKey),
+		Time:         tx.Transaction.Time,
+		Tx:           tx,
+		Txid:         tx.Transaction.Txid,
+		Name:         normalizerName(tx.Transaction.Txid),
+		History:      []string{tx.Transaction.Txid},
+		LastModified: tx.Transaction.Time,
+	}
+	el.Normalizer, err = encodeNormalizer(n, &el.Meta)
+	if err != nil {
+		return nil, err
+	}
+
+	bir := elastic.NewBulkIndexRequest().
+		Index(datastore.Index(normalizeIndex)).
+		Type("_doc").
+		Id(tx.Transaction.Txid).
+		Doc(el)
+
+	return bir, nil
+}
+
+// encodeNormalizer returns the json form of n and sets the fields of meta derived from it
+func encodeNormalizer(n *pb_oip5.NormalizeRecordProto, meta *NMeta) (json.RawMessage, error) {
+	var m jsonpb.Marshaler
+	var buf bytes.Buffer
+	err := m.Marshal(&buf, n)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := proto.Marshal(n)
+	if err != nil {
+		return nil, err
+	}
+
+	meta.NormalizerRaw = base64.StdEncoding.EncodeToString(raw)
+	meta.MainTemplate = templateName(n.MainTemplate)
+	return buf.Bytes(), nil
+}
+
+func validateNormalizer(n *pb_oip5.NormalizeRecordProto) error {
+	if n.MainTemplate == 0 {
+		return errors.New("missing main template")
+	}
+	if len(n.Fields) == 0 {
+		return errors.New("no fields to normalize")
+	}
+	if len(n.Fields) > maxNormalizerFields {
+		return fmt.Errorf("more than %d fields to normalize", maxNormalizerFields)
+	}
+	names := make(map[string]bool)
+	for i, f := range n.Fields {
+		if f == nil || !normalizedFieldName.MatchString(f.Name) {
+			return fmt.Errorf("field %d has an invalid name", i)
+		}
+		if names[f.Name] {
+			return fmt.Errorf("duplicate field %s", f.Name)
+		}
+		names[f.Name] = true
+		if len(f.Path) == 0 || len(f.Path) > maxNormalizePathDepth {
+			return fmt.Errorf("field %s must have between 1 and %d path steps", f.Name, maxNormalizePathDepth)
+		}
+		for _, step := range f.Path {
+			if step == nil || step.Template == 0 || step.Field == 0 {
+				return fmt.Errorf("field %s has an incomplete path step", f.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// mappedFields estimates the fields n adds to the normalized mapping, its object
+// and each field mapped as text with a keyword sub field
+func mappedFields(n *normalizer) int {
+	return 1 + 2*len(n.Normalizer.Fields)
+}
+
+// normalizedFieldsUsed sums the mapped fields of the applied normalizers other than txid
+func normalizedFieldsUsed(txid string) int {
+	normalizerCacheMutex.RLock()
+	defer normalizerCacheMutex.RUnlock()
+	used := 0
+	for _, n := range normalizerCache {
+		if n.Meta.Applied && n.Meta.Txid != txid {
+			used += mappedFields(n)
+		}
+	}
+	return used
+}
+
+func normalizerName(txid string) string {
+	return "nrm_" + strings.ToUpper(txid[:8])
+}
+
+func templateName(identifier uint32) string {
+	return fmt.Sprintf("tmpl_%08X", identifier)
+}
+
+func decodeNormalizer(el elasticOip5Normalizer) (*normalizer, error) {
+	raw, err := base64.StdEncoding.DecodeString(el.Meta.NormalizerRaw)
+	if err != nil {
+		return nil, err
+	}
+	n := &normalizer{Normalizer: &pb_oip5.NormalizeRecordProto{}, Meta: el.Meta}
+	err = proto.Unmarshal(raw, n.Normalizer)
+	if err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+func getNormalizer(txid string) *normalizer {
+	normalizerCacheMutex.RLock()
+	defer normalizerCacheMutex.RUnlock()
+	return normalizerCache[txid]
+}
+
+func cacheNormalizer(n *normalizer) {
+	normalizerCacheMutex.Lock()
+	normalizerCache[n.Meta.Txid] = n
+	normalizerCacheMutex.Unlock()
+}
+
+// loadNormalizersFromES fills the normalizer cache with every valid normalizer
+func loadNormalizersFromES(ctx context.Context) error {
+	res, err := datastore.Client().
+		Search(datastore.Index(normalizeIndex)).
+		Type("_doc").
+		Query(elastic.NewTermQuery("meta.invalid", false)).
+		Size(10000).
+		Do(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range res.Hits.Hits {
+		var el elasticOip5Normalizer
+		err := json.Unmarshal(*v.Source, &el)
+		if err != nil {
+			return err
+		}
+		n, err := decodeNormalizer(el)
+		if err != nil {
+			log.Error("unable to decode normalizer", logger.Attrs{"err": err, "txid": el.Meta.Txid})
+			continue
+		}
+		cacheNormalizer(n)
+	}
+
+	return nil
+}
+
+// onDatastoreCommitNormalizers applies new and edited normalizers to the
+// records already indexed
+func onDatastoreCommitNormalizers() {
+	normalizeCommitMutex.Lock()
+	defer normalizeCommitMutex.Unlock()
+
+	q := elastic.NewBoolQuery().Must(
+		elastic.NewTermQuery("meta.applied", false),
+		elastic.NewTermQuery("meta.invalid", false),
+	)
+	res, err := datastore.Client().
+		Search(datastore.Index(normalizeIndex)).
+		Type("_doc").
+		Query(q).
+		Size(10000).
+		Sort("meta.time", true).
+		Do(context.TODO())
+	if err != nil {
+		log.Error("elastic search failed", logger.Attrs{"err": err})
+		return
+	}
+
+	for _, v := range res.Hits.Hits {
+		var el elasticOip5Normalizer
+		err := json.Unmarshal(*v.Source, &el)
+		if err != nil {
+			log.Info("failed to unmarshal elastic hit", logger.Attrs{"err": err})
+			continue
+		}
+
+		// the applied flag may not be committed yet
+		if c := getNormalizer(el.Meta.Txid); c != nil && c.Meta.Applied && c.Meta.LastModified == el.Meta.LastModified {
+			continue
+		}
+
+		n, err := decodeNormalizer(el)
+		if err != nil {
+			log.Error("unable to decode normalizer", logger.Attrs{"err": err, "txid": el.Meta.Txid})
+			markNormalizerInvalid(el.Meta.Txid)
+			continue
+		}
+
+		if normalizedFieldsUsed(n.Meta.Txid)+mappedFields(n) > normalizedFieldBudget {
+			log.Error("normalizer exceeds the normalized field budget", logger.Attrs{"txid": n.Meta.Txid, "fields": len(n.Normalizer.Fields)})
+			normalizerCacheMutex.Lock()
+			delete(normalizerCache, n.Meta.Txid)
+			normalizerCacheMutex.Unlock()
+			markNormalizerInvalid(n.Meta.Txid)
+			continue
+		}
+
+		log.Info("applying normalizer", logger.Attrs{"txid": n.Meta.Txid, "mainTemplate": n.Meta.MainTemplate})
+		err = applyNormalizer(n)
+		if err != nil {
+			log.Error("unable to apply normalizer", logger.Attrs{"err": err, "txid": n.Meta.Txid})
+			continue
+		}
+
+		n.Meta.Applied = true
+		cacheNormalizer(n)
+
+		bur := elastic.NewBulkUpdateRequest().
+			Index(datastore.Index(normalizeIndex)).
+			Type("_doc").
+			Id(n.Meta.Txid).
+			Doc(MetaApplied{Applied{true}})
+		datastore.AutoBulk.Add(bur)
+	}
+}
+
+// applyNormalizer replaces the normalized views of n for every latest record
+// containing its main template
+func applyNormalizer(n *normalizer) error {
+	_, err := datastore.Client().
+		DeleteByQuery(datastore.Index(normalizedIndex)).
+		Type("_doc").
+		Query(elastic.NewTermQuery("meta.normalizer", n.Meta.Txid)).
+		Refresh("true").
+		Do(context.TODO())
+	if err != nil {
+		return err
+	}
+
+	searchSize := 1000
+	q := elastic.NewBoolQuery().Must(
+		elastic.NewTermQuery("meta.templates", n.Meta.MainTemplate),
+		elastic.NewTermQuery("meta.latest", true),
+	)
+
+	var after []interface{}
+	for {
+		search := datastore.Client().
+			Search(datastore.Index(o5RecordIndexName)).
+			Type("_doc").
+			Query(q).
+			Size(searchSize).
+			Sort("meta.txid", true)
+		if after != nil {
+			search.SearchAfter(after...)
+		}
+		res, err := search.Do(context.TODO())
+		if err != nil {
+			return err
+		}
+
+		for _, v := range res.Hits.Hits {
+			var eRec elasticOip5Record
+			err := json.Unmarshal(*v.Source, &eRec)
+			if err != nil {
+				log.Info("failed to unmarshal elastic hit", logger.Attrs{"err": err})
+				continue
+			}
+			rec, err := decodeElasticRecord(eRec)
+			if err != nil {
+				log.Error("unable to decode record", logger.Attrs{"err": err, "txid": eRec.Meta.Txid})
+				continue
+			}
+			if bir := normalizedRequest(n, rec); bir != nil {
+				datastore.AutoBulk.Add(bir)
+			}
+		}
+
+		if len(res.Hits.Hits) < searchSize {
+			return nil
+		}
+		after = res.Hits.Hits[len(res.Hits.Hits)-1].Sort
+	}
+}
+
+func decodeElasticRecord(eRec elasticOip5Record) (*oip5Record, error) {
+	rec := &oip5Record{
+		Meta:   eRec.Meta,
+		Record: &pb_oip5.RecordProto{},
+	}
+	raw, err := base64.StdEncoding.DecodeString(eRec.Meta.RecordRaw)
+	if err != nil {
+		return nil, err
+	}
+	err = proto.Unmarshal(raw, rec.Record)
+	if err != nil {
+		return nil, err
+	}
+	return rec, nil
+}
+
+// normalizeRecord indexes the normalized views of rec for every known normalizer
+// of its templates, tx is nil for records modified by an edit
+func normalizeRecord(rec *oip5Record, tx *datastore.TransactionData) {
+	normalizerCacheMutex.RLock()
+	var ns []*normalizer
+	for _, n := range normalizerCache {
+		ns = append(ns, n)
+	}
+	normalizerCacheMutex.RUnlock()
+
+	for _, n := range ns {
+		bir := normalizedRequest(n, rec)
+		if bir == nil {
+			continue
+		}
+		if tx != nil {
+			datastore.AutoBulk.AddFor(tx, bir)
+		} else {
+			datastore.AutoBulk.Add(bir)
+		}
+	}
+}
+
+// normalizedRequest returns the index request of the view of rec through n, nil
+// if rec does not contain the main template of n
+func normalizedRequest(n *normalizer, rec *oip5Record) *elastic.BulkIndexRequest {
+	fields := normalize(n, rec)
+	if fields == nil {
+		return nil
+	}
+
+	el := elasticOip5Normalized{
+		Normalized: map[string]map[string]interface{}{n.Meta.Name: fields},
+		Meta: NormalizedMeta{
+			Normalizer:    n.Meta.Txid,
+			Name:          n.Meta.Name,
+			Original:      rec.Meta.Original,
+			Txid:          rec.Meta.Txid,
+			SignedBy:      rec.Meta.SignedBy,
+			PublisherName: rec.Meta.PublisherName,
+			Deactivated:   rec.Meta.Deactivated,
+			Time:          rec.Meta.Time,
+			LastModified:  rec.Meta.LastModified,
+		},
+	}
+
+	return elastic.NewBulkIndexRequest().
+		Index(datastore.Index(normalizedIndex)).
+		Type("_doc").
+		Id(n.Meta.Txid + "-" + rec.Meta.Original).
+		Doc(el)
+}
+
+// normalize returns the named fields of rec selected by n; fields without a
+// value are omitted and fields with several values are arrays
+func normalize(n *normalizer, rec *oip5Record) map[string]interface{} {
+	found := false
+	for _, t := range recordTemplateNames(rec.Record) {
+		if strings.EqualFold(t, n.Meta.MainTemplate) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil
+	}
+
+	fields := make(map[string]interface{})
+	for _, f := range n.Normalizer.Fields {
+		values := resolvePath(rec.Record, f.Path)
+		switch len(values) {
+		case 0:
+		case 1:
+			fields[f.Name] = values[0]
+		default:
+			fields[f.Name] = values
+		}
+	}
+	return fields
+}
+
+// resolvePath follows path through r, each step but the last selecting a txid
+// field referencing the record the next step applies to
+func resolvePath(r *pb_oip5.RecordProto, path []*pb_oip5.Path) []interface{} {
+	step := path[0]
+	msg := recordDetail(r, step.Template)
+	if msg == nil {
+		return nil
+	}
+	fd := msg.GetMessageDescriptor().FindFieldByNumber(int32(step.Field))
+	if fd == nil || !msg.HasField(fd) {
+		return nil
+	}
+
+	v := msg.GetField(fd)
+	values := []interface{}{v}
+	if l, ok := v.([]interface{}); ok {
+		values = l
+	}
+
+	if len(path) == 1 {
+		for i, v := range values {
+			values[i] = normalizedValue(fd, v)
+		}
+		return values
+	}
+
+	var res []interface{}
+	for _, v := range values {
+		txid, ok := txidValue(v)
+		if !ok {
+			continue
+		}
+		ref, err := GetRecord(txid)
+		if err != nil {
+			log.Info("unable to obtain referenced record", logger.Attrs{"err": err, "txid": txid})
+			continue
+		}
+		res = append(res, resolvePath(ref.Record, path[1:])...)
+	}
+	return res
+}
+
+// recordDetail decodes the detail of r of the template identifier
+func recordDetail(r *pb_oip5.RecordProto, identifier uint32) *dynamic.Message {
+	if r.Details == nil {
+		return nil
+	}
+	name := templateName(identifier)
+	for _, d := range r.Details.Details {
+		i := strings.LastIndex(d.TypeUrl, ".")
+		if i == -1 || !strings.EqualFold(d.TypeUrl[i+1:], name) {
+			continue
+		}
+		dm, err := decodeDetail(d)
+		if err != nil {
+			log.Info("unable to decode detail", logger.Attrs{"err": err, "type": d.TypeUrl})
+			return nil
+		}
+		return dm
+	}
+	return nil
+}
+
+// decodeDetail decodes a record detail as a dynamic message of its template
+func decodeDetail(d *any.Any) (*dynamic.Message, error) {
+	m, err := templates.CreateNewMessage(d.TypeUrl[strings.LastIndex(d.TypeUrl, "/")+1:])
+	if err != nil {
+		return nil, err
+	}
+	err = proto.Unmarshal(d.Value, m)
+	if err != nil {
+		return nil, err
+	}
+	return dynamic.AsDynamicMessage(m)
+}
+
+var txidMessageName = proto.MessageName(&pb_oip.Txid{})
+
+func txidValue(v interface{}) (string, bool) {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return "", false
+	}
+	dm, err := dynamic.AsDynamicMessage(m)
+	if err != nil || dm.GetMessageDescriptor().GetFullyQualifiedName() != txidMessageName {
+		return "", false
+	}
+	b, err := proto.Marshal(m)
+	if err != nil {
+		return "", false
+	}
+	txid := &pb_oip.Txid{}
+	err = proto.Unmarshal(b, txid)
+	if err != nil {
+		return "", false
+	}
+	return pb_oip.TxidToString(txid), true
+}
+
+// normalizedValue converts a field value into its json form: enums by name,
+// txids as strings and other messages as objects
+func normalizedValue(fd *desc.FieldDescriptor, v interface{}) interface{} {
+	switch val := v.(type) {
+	case []interface{}:
+		l := make([]interface{}, len(val))
+		for i, e := range val {
+			l[i] = normalizedValue(fd, e)
+		}
+		return l
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(val))
+		for k, e := range val {
+			m[fmt.Sprint(k)] = normalizedValue(fd.GetMapValueType(), e)
+		}
+		return m
+	case int32:
+		if et := fd.GetEnumType(); et != nil {
+			if ev := et.FindValueByNumber(val); ev != nil {
+				return ev.GetName()
+			}
+		}
+	case proto.Message:
+		if txid, ok := txidValue(val); ok {
+			return txid
+		}
+		dm, err := dynamic.AsDynamicMessage(val)
+		if err != nil {
+			return nil
+		}
+		b, err := dm.MarshalJSON()
+		if err != nil {
+			return nil
+		}
+		return json.RawMessage(b)
+	}
+	return v
+}
+
+func markNormalizerInvalid(txid string) {
+	bur := elastic.NewBulkUpdateRequest().
+		Index(datastore.Index(normalizeIndex)).
+		Type("_doc").
+		Id(txid).
+		Doc(MetaInvalid{Invalid{true}})
+
+	datastore.AutoBulk.Add(bur)
+}
+
+// editNormalizer applies a patch to a normalizer, the records are normalized
+// again once the edited normalizer is committed
+func editNormalizer(n *normalizer, edit elasticOip5Edit) {
+	if n.Meta.SignedBy != edit.Meta.SignedBy {
+		log.Error("edit not signed by normalizer owner", logger.Attrs{"reference": edit.Reference, "txid": edit.Meta.Txid})
+		markEditInvalid(edit.Meta.Txid)
+		return
+	}
+
+	b, err := base64.StdEncoding.DecodeString(edit.PatchRaw)
+	if err != nil {
+		markEditInvalid(edit.Meta.Txid)
+		log.Error("unable to decode raw patch", logger.Attrs{"err": err, "reference": edit.Reference, "txid": edit.Meta.Txid})
+		return
+	}
+
+	pp := &patch.ProtoPatch{}
+	err = proto.Unmarshal(b, pp)
+	if err != nil {
+		markEditInvalid(edit.Meta.Txid)
+		log.Error("unable to decode proto patch for edit", logger.Attrs{"err": err, "reference": edit.Reference, "txid": edit.Meta.Txid})
+		return
+	}
+
+	p, err := patch.FromProto(pp)
+	if err != nil {
+		markEditInvalid(edit.Meta.Txid)
+		log.Error("unable to decode patch for edit", logger.Attrs{"err": err, "reference": edit.Reference, "txid": edit.Meta.Txid})
+		return
+	}
+
+	a, err := patch.ApplyPatch(*p, proto.Clone(n.Normalizer))
+	if err != nil {
+		markEditInvalid(edit.Meta.Txid)
+		log.Error("unable to apply edit to normalizer", logger.Attrs{"err": err, "reference": edit.Reference, "txid": edit.Meta.Txid})
+		return
+	}
+
+	newNorm, ok := a.(*pb_oip5.NormalizeRecordProto)
+	if !ok {
+		markEditInvalid(edit.Meta.Txid)
+		log.Error("patch result is no longer a normalizer", logger.Attrs{"reference": edit.Reference, "txid": edit.Meta.Txid})
+		return
+	}
+	err = validateNormalizer(newNorm)
+	if err != nil {
+		markEditInvalid(edit.Meta.Txid)
+		log.Error("edited normalizer is invalid", logger.Attrs{"err": err, "reference": edit.Reference, "txid": edit.Meta.Txid})
+		return
+	}
+
+	edited := &normalizer{Normalizer: newNorm, Meta: n.Meta}
+	edited.Meta.Applied = false
+	edited.Meta.History = append(append([]string(nil), n.Meta.History...), edit.Meta.Txid)
+	edited.Meta.LastModified = edit.Meta.Time
+
+	var el elasticOip5Normalizer
+	el.Normalizer, err = encodeNormalizer(newNorm, &edited.Meta)
+	if err != nil {
+		markEditInvalid(edit.Meta.Txid)
+		log.Error("unable to marshal normalizer post edit", logger.Attrs{"err": err, "reference": edit.Reference, "txid": edit.Meta.Txid})
+		return
+	}
+	el.Meta = edited.Meta
+
+	cacheNormalizer(edited)
+
+	bir := elastic.NewBulkIndexRequest().
+		Index(datastore.Index(normalizeIndex)).
+		Type("_doc").
+		Id(edited.Meta.Txid).
+		Doc(el)
+	datastore.AutoBulk.Add(bir)
+
+	bur := elastic.NewBulkUpdateRequest().
+		Index(datastore.Index(editIndex)).
+		Type("_doc").
+		Id(edit.Meta.Txid).
+		Doc(MetaApplied{Applied{true}})
+	datastore.AutoBulk.Add(bur)
+}
@@ -0,0 +1,282 @@
+package oip5
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/jhump/protoreflect/dynamic"
+	"github.com/oipwg/proto/go/pb_oip"
+	"github.com/oipwg/proto/go/pb_oip5"
+
+	"github.com/oipwg/oip/datastore"
+	"github.com/oipwg/oip/datastore/datastoretest"
+	"github.com/oipwg/oip/modules/oip5/templates"
+)
+
+const (
+	artistTemplateTxid = "c0ffee0100000000000000000000000000000000000000000000000000000000"
+	albumTemplateTxid  = "c0ffee0200000000000000000000000000000000000000000000000000000000"
+	artistTemplate     = 0xc0ffee01
+	albumTemplate      = 0xc0ffee02
+	artistTxid         = "a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1"
+	albumTxid          = "b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2"
+	normalizerTxid     = "d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3"
+)
+
+func field(name string, number int32, label descriptor.FieldDescriptorProto_Label, typ descriptor.FieldDescriptorProto_Type) *descriptor.FieldDescriptorProto {
+	return &descriptor.FieldDescriptorProto{
+		Name:     proto.String(name),
+		JsonName: proto.String(name),
+		Number:   proto.Int32(number),
+		Label:    label.Enum(),
+		Type:     typ.Enum(),
+	}
+}
+
+// registerTemplate registers a template message P with the given fields as
+// protobuf.js would describe it, with txid fields referencing a nested Txid
+func registerTemplate(t *testing.T, txid string, identifier uint32, fields ...*descriptor.FieldDescriptorProto) {
+	t.Helper()
+	fdp := &descriptor.FileDescriptorProto{
+		Name:    proto.String("p.proto"),
+		Package: proto.String("oipProto.templates"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptor.DescriptorProto{{
+			Name:  proto.String("P"),
+			Field: fields,
+			NestedType: []*descriptor.DescriptorProto{{
+				Name:  proto.String("Txid"),
+				Field: []*descriptor.FieldDescriptorProto{field("raw", 1, descriptor.FieldDescriptorProto_LABEL_OPTIONAL, descriptor.FieldDescriptorProto_TYPE_BYTES)},
+			}},
+		}},
+	}
+	b, err := proto.Marshal(&descriptor.FileDescriptorSet{File: []*descriptor.FileDescriptorProto{fdp}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = templates.DecodeDescriptorSet(&templates.RecordTemplate{Txid: txid, Identifier: identifier}, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func registerTestTemplates(t *testing.T) {
+	txidField := field("artist", 2, descriptor.FieldDescriptorProto_LABEL_OPTIONAL, descriptor.FieldDescriptorProto_TYPE_MESSAGE)
+	txidField.TypeName = proto.String(".oipProto.templates.P.Txid")
+
+	registerTemplate(t, artistTemplateTxid, artistTemplate,
+		field("name", 1, descriptor.FieldDescriptorProto_LABEL_OPTIONAL, descriptor.FieldDescriptorProto_TYPE_STRING))
+	registerTemplate(t, albumTemplateTxid, albumTemplate,
+		field("title", 1, descriptor.FieldDescriptorProto_LABEL_OPTIONAL, descriptor.FieldDescriptorProto_TYPE_STRING),
+		txidField,
+		field("tracks", 3, descriptor.FieldDescriptorProto_LABEL_REPEATED, descriptor.FieldDescriptorProto_TYPE_STRING))
+}
+
+func detail(t *testing.T, templateTxid string, values map[string]interface{}) *any.Any {
+	t.Helper()
+	tmpl, err := templates.GetTemplate(templateTxid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dm := dynamic.NewMessage(tmpl.MessageDescriptor)
+	for k, v := range values {
+		dm.SetFieldByName(k, v)
+	}
+	b, err := dm.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &any.Any{TypeUrl: "type.googleapis.com/oipProto.templates." + tmpl.Name, Value: b}
+}
+
+func testRecord(txid string, details ...*any.Any) *oip5Record {
+	r := &pb_oip5.RecordProto{Details: &pb_oip5.OipDetails{Details: details}}
+	return &oip5Record{
+		Record: r,
+		Meta: RMeta{
+			Txid:      txid,
+			Original:  txid,
+			Latest:    true,
+			Templates: recordTemplateNames(r),
+		},
+	}
+}
+
+func testNormalizer() *pb_oip5.NormalizeRecordProto {
+	return &pb_oip5.NormalizeRecordProto{
+		MainTemplate: albumTemplate,
+		Fields: []*pb_oip5.NormalizeField{
+			{Name: "title", Path: []*pb_oip5.Path{{Template: albumTemplate, Field: 1}}},
+			{Name: "artist", Path: []*pb_oip5.Path{{Template: albumTemplate, Field: 2}, {Template: artistTemplate, Field: 1}}},
+			{Name: "artist_txid", Path: []*pb_oip5.Path{{Template: albumTemplate, Field: 2}}},
+			{Name: "tracks", Path: []*pb_oip5.Path{{Template: albumTemplate, Field: 3}}},
+			{Name: "missing", Path: []*pb_oip5.Path{{Template: artistTemplate, Field: 1}}},
+		},
+	}
+}
+
+func manyFields(count int) []*pb_oip5.NormalizeField {
+	fields := make([]*pb_oip5.NormalizeField, count)
+	for i := range fields {
+		fields[i] = &pb_oip5.NormalizeField{Name: fmt.Sprintf("f%d", i), Path: []*pb_oip5.Path{{Template: 1, Field: 1}}}
+	}
+	return fields
+}
+
+func TestValidateNormalizer(t *testing.T) {
+	path := []*pb_oip5.Path{{Template: 1, Field: 1}}
+	cases := []struct {
+		name  string
+		n     *pb_oip5.NormalizeRecordProto
+		valid bool
+	}{
+		{"valid", &pb_oip5.NormalizeRecordProto{MainTemplate: 1, Fields: []*pb_oip5.NormalizeField{{Name: "a", Path: path}}}, true},
+		{"no main template", &pb_oip5.NormalizeRecordProto{Fields: []*pb_oip5.NormalizeField{{Name: "a", Path: path}}}, false},
+		{"no fields", &pb_oip5.NormalizeRecordProto{MainTemplate: 1}, false},
+		{"invalid name", &pb_oip5.NormalizeRecordProto{MainTemplate: 1, Fields: []*pb_oip5.NormalizeField{{Name: "a.b", Path: path}}}, false},
+		{"duplicate name", &pb_oip5.NormalizeRecordProto{MainTemplate: 1, Fields: []*pb_oip5.NormalizeField{{Name: "a", Path: path}, {Name: "a", Path: path}}}, false},
+		{"empty path", &pb_oip5.NormalizeRecordProto{MainTemplate: 1, Fields: []*pb_oip5.NormalizeField{{Name: "a"}}}, false},
+		{"incomplete step", &pb_oip5.NormalizeRecordProto{MainTemplate: 1, Fields: []*pb_oip5.NormalizeField{{Name: "a", Path: []*pb_oip5.Path{{Template: 1}}}}}, false},
+		{"too many fields", &pb_oip5.NormalizeRecordProto{MainTemplate: 1, Fields: manyFields(maxNormalizerFields + 1)}, false},
+		{"path too deep", &pb_oip5.NormalizeRecordProto{MainTemplate: 1, Fields: []*pb_oip5.NormalizeField{{Name: "a", Path: make([]*pb_oip5.Path, maxNormalizePathDepth+1)}}}, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if err := validateNormalizer(c.n); (err == nil) != c.valid {
+				t.Errorf("validateNormalizer() = %v, want valid %v", err, c.valid)
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	registerTestTemplates(t)
+
+	artist := testRecord(artistTxid, detail(t, artistTemplateTxid, map[string]interface{}{"name": "Ryan"}))
+	recordCache.Add(artistTxid, artist)
+	defer recordCache.Remove(artistTxid)
+
+	album := testRecord(albumTxid, detail(t, albumTemplateTxid, map[string]interface{}{
+		"title":  "Songs",
+		"artist": pb_oip.TxidFromString(artistTxid),
+		"tracks": []string{"one", "two"},
+	}))
+
+	n := &normalizer{Normalizer: testNormalizer(), Meta: NMeta{Txid: normalizerTxid, Name: normalizerName(normalizerTxid), MainTemplate: templateName(albumTemplate)}}
+	got := normalize(n, album)
+	want := map[string]interface{}{
+		"title":       "Songs",
+		"artist":      "Ryan",
+		"artist_txid": artistTxid,
+		"tracks":      []interface{}{"one", "two"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("normalize() = %v, want %v", got, want)
+	}
+
+	if got := normalize(n, artist); got != nil {
+		t.Errorf("normalized record without the main template %v", got)
+	}
+}
+
+func TestOnDatastoreCommitNormalizers(t *testing.T) {
+	registerTestTemplates(t)
+
+	s := datastoretest.NewServer()
+	defer s.Close()
+	datastore.SetClient(s.Client())
+
+	artist := testRecord(artistTxid, detail(t, artistTemplateTxid, map[string]interface{}{"name": "Ryan"}))
+	album := testRecord(albumTxid, detail(t, albumTemplateTxid, map[string]interface{}{"title": "Songs", "artist": pb_oip.TxidFromString(artistTxid)}))
+	for _, r := range []*oip5Record{artist, album} {
+		raw, err := proto.Marshal(r.Record)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r.Meta.RecordRaw = base64.StdEncoding.EncodeToString(raw)
+		s.Put(datastore.Index(o5RecordIndexName), r.Meta.Txid, elasticOip5Record{Record: json.RawMessage(`{}`), Meta: r.Meta})
+	}
+	// a view left behind by a previous version of the normalizer
+	s.Put(datastore.Index(normalizedIndex), normalizerTxid+"-stale", map[string]interface{}{"meta": map[string]interface{}{"normalizer": normalizerTxid}})
+
+	meta := NMeta{Txid: normalizerTxid, Name: normalizerName(normalizerTxid), History: []string{normalizerTxid}}
+	nj, err := encodeNormalizer(testNormalizer(), &meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Put(datastore.Index(normalizeIndex), normalizerTxid, elasticOip5Normalizer{Normalizer: nj, Meta: meta})
+	defer func() {
+		normalizerCacheMutex.Lock()
+		delete(normalizerCache, normalizerTxid)
+		normalizerCacheMutex.Unlock()
+	}()
+
+	onDatastoreCommitNormalizers()
+	datastore.AutoBulk.Commit()
+
+	if ids := s.IDs(datastore.Index(normalizedIndex)); !reflect.DeepEqual(ids, []string{normalizerTxid + "-" + albumTxid}) {
+		t.Fatalf("normalized views %v", ids)
+	}
+	b, _ := s.Get(datastore.Index(normalizedIndex), normalizerTxid+"-"+albumTxid)
+	var view elasticOip5Normalized
+	if err := json.Unmarshal(b, &view); err != nil {
+		t.Fatal(err)
+	}
+	fields := view.Normalized[meta.Name]
+	if fields["title"] != "Songs" || fields["artist"] != "Ryan" || view.Meta.Original != albumTxid {
+		t.Errorf("unexpected normalized view %s", b)
+	}
+
+	b, _ = s.Get(datastore.Index(normalizeIndex), normalizerTxid)
+	var el elasticOip5Normalizer
+	if err := json.Unmarshal(b, &el); err != nil {
+		t.Fatal(err)
+	}
+	if !el.Meta.Applied || getNormalizer(normalizerTxid) == nil {
+		t.Errorf("normalizer not applied %s", b)
+	}
+}
+
+func TestNormalizedFieldBudget(t *testing.T) {
+	s := datastoretest.NewServer()
+	defer s.Close()
+	datastore.SetClient(s.Client())
+
+	// applied normalizers leaving less room than the normalizer needs
+	const fullTxid = "e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4"
+	full := &normalizer{
+		Normalizer: &pb_oip5.NormalizeRecordProto{Fields: manyFields((normalizedFieldBudget - 2) / 2)},
+		Meta:       NMeta{Txid: fullTxid, Applied: true},
+	}
+	cacheNormalizer(full)
+	defer func() {
+		normalizerCacheMutex.Lock()
+		delete(normalizerCache, fullTxid)
+		normalizerCacheMutex.Unlock()
+	}()
+
+	meta := NMeta{Txid: normalizerTxid, Name: normalizerName(normalizerTxid), History: []string{normalizerTxid}}
+	nj, err := encodeNormalizer(testNormalizer(), &meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Put(datastore.Index(normalizeIndex), normalizerTxid, elasticOip5Normalizer{Normalizer: nj, Meta: meta})
+
+	onDatastoreCommitNormalizers()
+	datastore.AutoBulk.Commit()
+
+	b, _ := s.Get(datastore.Index(normalizeIndex), normalizerTxid)
+	var el elasticOip5Normalizer
+	if err := json.Unmarshal(b, &el); err != nil {
+		t.Fatal(err)
+	}
+	if el.Meta.Applied || !el.Meta.Invalid || getNormalizer(normalizerTxid) != nil {
+		t.Errorf("normalizer beyond the field budget applied %s", b)
+	}
+}
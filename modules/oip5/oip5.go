@@ -1,6 +1,8 @@
 package oip5
 
 import (
+	"sync/atomic"
+
 	"github.com/azer/logger"
 	"github.com/golang/protobuf/proto"
 	"github.com/oipwg/proto/go/pb_oip"
@@ -8,15 +10,15 @@ import (
 
 	"github.com/oipwg/oip/datastore"
 	"github.com/oipwg/oip/events"
+	"github.com/oipwg/oip/metrics"
+	"github.com/oipwg/oip/modules/module"
 	"github.com/oipwg/oip/modules/oip5/templates"
+	"github.com/oipwg/oip/rejections"
 )
 
-func init() {
+func initOip5(hooks *module.Hooks) {
 	log.Info("init oip5")
-	events.SubscribeAsync("modules:oip5:msg", on5msg)
-
-	datastore.RegisterMapping("oip5_templates", "oip5_templates.json")
-	datastore.RegisterMapping("oip5_record", "oip5_record.json")
+	hooks.Subscribe("modules:oip5:msg", on5msg)
 }
 
 func on5msg(msg *pb_oip.SignedMessage, tx *datastore.TransactionData) {
@@ -29,6 +31,7 @@ func on5msg(msg *pb_oip.SignedMessage, tx *datastore.TransactionData) {
 	if err != nil {
 		attr["err"] = err
 		log.Error("unable to unmarshal serialized message", attr)
+		rejections.Reject(tx, "oip5", rejections.StageUnmarshal, "unable to unmarshal oip5 message", err)
 		return
 	}
 
@@ -39,23 +42,55 @@ func on5msg(msg *pb_oip.SignedMessage, tx *datastore.TransactionData) {
 		if err != nil {
 			attr["err"] = err
 			log.Error("unable to process RecordTemplate", attr)
+			rejections.Reject(tx, "oip5", rejections.StageValidate, "invalid record template", err)
 		} else {
 			attr["templateName"] = o5.RecordTemplate.FriendlyName
 			log.Info("adding RecordTemplate", attr)
-			datastore.AutoBulk.Add(bir)
+			datastore.AutoBulk.AddFor(tx, bir)
+			metrics.Accepted("oip5")
+			atomic.StoreInt32(&templatesAdded, 1)
 		}
 	}
 
-	if o5.Record != nil {
+	if o5.Record != nil && transferTemplate.of(o5.Record) {
 		nonNilAction = true
-		bir, err := intakeRecord(o5.Record, msg.PubKey, tx)
+		bir, err := intakeTransfer(o5.Record, msg.PubKey, tx)
+		if err != nil {
+			attr["err"] = err
+			log.Error("unable to process Transfer", attr)
+			rejections.Reject(tx, "oip5", rejections.StageValidate, "invalid transfer", err)
+		} else {
+			log.Info("adding o5 transfer", attr)
+			datastore.AutoBulk.AddFor(tx, bir)
+			metrics.Accepted("oip5")
+		}
+	} else if o5.Record != nil && deactivateTemplate.of(o5.Record) {
+		nonNilAction = true
+		bir, err := intakeDeactivate(o5.Record, msg.PubKey, tx)
+		if err != nil {
+			attr["err"] = err
+			log.Error("unable to process Deactivate", attr)
+			rejections.Reject(tx, "oip5", rejections.StageValidate, "invalid deactivation", err)
+		} else {
+			log.Info("adding o5 deactivation", attr)
+			datastore.AutoBulk.AddFor(tx, bir)
+			metrics.Accepted("oip5")
+		}
+	} else if o5.Record != nil {
+		nonNilAction = true
+		bir, rec, err := intakeRecord(o5.Record, msg.PubKey, tx)
 		if err != nil {
 			attr["err"] = err
 			log.Error("unable to process Record", attr)
+			rejections.Reject(tx, "oip5", rejections.StageValidate, "invalid record", err)
 		} else {
 			attr["deets"] = o5.Record.Details
 			log.Info("adding o5 record", attr)
-			datastore.AutoBulk.Add(bir)
+			datastore.AutoBulk.AddFor(tx, bir)
+			metrics.Accepted("oip5")
+
+			normalizeRecord(rec, tx)
+			indexReferences(rec, nil, tx)
 
 			events.Publish("modules:oip5:record", o5.Record, msg.PubKey, tx)
 		}
@@ -63,7 +98,16 @@ func on5msg(msg *pb_oip.SignedMessage, tx *datastore.TransactionData) {
 
 	if o5.Normalize != nil {
 		nonNilAction = true
-		log.Error("oip5 normalize not yet supported")
+		bir, err := intakeNormalizer(o5.Normalize, msg.PubKey, tx)
+		if err != nil {
+			attr["err"] = err
+			log.Error("unable to process Normalize", attr)
+			rejections.Reject(tx, "oip5", rejections.StageValidate, "invalid normalizer", err)
+		} else {
+			log.Info("adding o5 normalizer", attr)
+			datastore.AutoBulk.AddFor(tx, bir)
+			metrics.Accepted("oip5")
+		}
 	}
 
 	if o5.Edit != nil {
@@ -73,9 +117,11 @@ func on5msg(msg *pb_oip.SignedMessage, tx *datastore.TransactionData) {
 		if err != nil {
 			attr["err"] = err
 			log.Error("unable to process Edit", attr)
+			rejections.Reject(tx, "oip5", rejections.StageValidate, "invalid edit", err)
 		} else {
 			log.Info("adding o5 edit", attr)
-			datastore.AutoBulk.Add(bir)
+			datastore.AutoBulk.AddFor(tx, bir)
+			metrics.Accepted("oip5")
 
 			events.Publish("modules:oip5:edit", o5.Record, msg.PubKey, tx)
 		}
@@ -83,5 +129,6 @@ func on5msg(msg *pb_oip.SignedMessage, tx *datastore.TransactionData) {
 
 	if !nonNilAction {
 		log.Error("no supported oip5 action to process", attr)
+		rejections.Reject(tx, "oip5", rejections.StageRoute, "no supported action", nil)
 	}
 }
@@ -13,26 +13,25 @@ import (
 	"github.com/spf13/viper"
 	"gopkg.in/olivere/elastic.v6"
 
-	"github.com/oipwg/oip/config"
 	"github.com/oipwg/oip/datastore"
-	"github.com/oipwg/oip/events"
+	"github.com/oipwg/oip/modules/module"
 )
 
 var publisherCacheDepth = 1000
 var publisherCache *lru.Cache
 
 func init() {
-	events.SubscribeAsync("modules:oip5:record", publisherListener)
-
 	publisherCache, _ = lru.New(publisherCacheDepth)
+}
 
-	config.OnPostConfig(func(ctx context.Context) {
-		pcd := viper.GetInt("oip.oip5.publisherCacheDepth")
-		if pcd != publisherCacheDepth && pcd > 0 {
-			publisherCacheDepth = pcd
-			publisherCache.Resize(publisherCacheDepth)
-		}
-	})
+func initPublisher(hooks *module.Hooks, cfg *viper.Viper) {
+	hooks.Subscribe("modules:oip5:record", publisherListener)
+
+	pcd := cfg.GetInt("publisherCacheDepth")
+	if pcd != publisherCacheDepth && pcd > 0 {
+		publisherCacheDepth = pcd
+		publisherCache.Resize(publisherCacheDepth)
+	}
 }
 
 func GetPublisherName(pubKey string) (string, error) {
@@ -67,8 +66,9 @@ func GetPublisherName(pubKey string) (string, error) {
 	return "", errors.New("unable to locate publisher")
 }
 
-func publisherListener(rec *pb_oip5.RecordProto, pubKey []byte, _ *datastore.TransactionData) {
-	if rec.Details == nil {
+func publisherListener(rec *pb_oip5.RecordProto, pubKey []byte, tx *datastore.TransactionData) {
+	// a traced registration must not rename the publisher
+	if rec.Details == nil || tx.GetTrace() != nil {
 		return
 	}
 	for _, det := range rec.Details.Details {
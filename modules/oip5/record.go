@@ -6,6 +6,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"strings"
 
 	"github.com/azer/logger"
 	"github.com/golang/protobuf/jsonpb"
@@ -17,7 +18,6 @@ import (
 	"github.com/spf13/viper"
 	"gopkg.in/olivere/elastic.v6"
 
-	"github.com/oipwg/oip/config"
 	"github.com/oipwg/oip/datastore"
 )
 
@@ -26,46 +26,46 @@ var recordCache *lru.Cache
 
 func init() {
 	recordCache, _ = lru.New(recordCacheDepth)
+}
 
-	config.OnPostConfig(func(ctx context.Context) {
-		rcd := viper.GetInt("oip.oip5.recordCacheDepth")
-		if rcd != recordCacheDepth && rcd > 0 {
-			recordCacheDepth = rcd
-			recordCache.Resize(recordCacheDepth)
-		}
-	})
+func initRecord(cfg *viper.Viper) {
+	rcd := cfg.GetInt("recordCacheDepth")
+	if rcd != recordCacheDepth && rcd > 0 {
+		recordCacheDepth = rcd
+		recordCache.Resize(recordCacheDepth)
+	}
 }
 
-func intakeRecord(r *pb_oip5.RecordProto, pubKey []byte, tx *datastore.TransactionData) (*elastic.BulkIndexRequest, error) {
-	m := jsonpb.Marshaler{}
+// intakeRecord returns the request indexing r along with the record indexed
+// The record is cached unless tx is being traced, as a traced record replaces
+// neither the stored record nor any edits applied to it
+func intakeRecord(r *pb_oip5.RecordProto, pubKey []byte, tx *datastore.TransactionData) (*elastic.BulkIndexRequest, *oip5Record, error) {
+	v := validateRecord(r)
+	if v != nil && v.Status == validationInvalid && recordValidation == validationReject {
+		return nil, nil, errors.New("invalid record: " + strings.Join(v.Problems, "; "))
+	}
+
+	var el elasticOip5Record
 
-	var buf bytes.Buffer
-	err := m.Marshal(&buf, r)
-	if err != nil {
-		return nil, err
+	// records awaiting a template can not be rendered as json until it arrives
+	if v == nil || v.Status != validationPending {
+		m := jsonpb.Marshaler{}
+		var buf bytes.Buffer
+		err := m.Marshal(&buf, r)
+		if err != nil {
+			return nil, nil, err
+		}
+		el.Record = buf.Bytes()
 	}
 
 	raw, err := proto.Marshal(r)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	raw64 := base64.StdEncoding.EncodeToString(raw)
 
 	strPubKey := string(pubKey)
-	pubName := ""
-	// Check to see if a publisher registration is contained
-	if r.Details != nil {
-		for i := range r.Details.Details {
-			if r.Details.Details[i].TypeUrl == registeredPublisherTypeUrl {
-				regPub := &pb_templates.Tmpl_433C2783{}
-				err := ptypes.UnmarshalAny(r.Details.Details[i], regPub)
-				if err != nil {
-					log.Error("unable to decode reg pub any", logger.Attrs{"err": err, "txid": tx.Transaction.Txid})
-				}
-				pubName = regPub.Name
-			}
-		}
-	}
+	pubName := registeredPublisherName(r, tx)
 	if pubName == "" {
 		pubName, err = GetPublisherName(strPubKey)
 		if err != nil {
@@ -73,8 +73,6 @@ func intakeRecord(r *pb_oip5.RecordProto, pubKey []byte, tx *datastore.Transacti
 		}
 	}
 
-	var el elasticOip5Record
-	el.Record = buf.Bytes()
 	el.Meta = RMeta{
 		Block:         tx.Block,
 		BlockHash:     tx.BlockHash,
@@ -90,6 +88,10 @@ func intakeRecord(r *pb_oip5.RecordProto, pubKey []byte, tx *datastore.Transacti
 		Type:          "oip5",
 		RecordRaw:     raw64,
 		Latest:        true,
+		Templates:     recordTemplateNames(r),
+		Owner:         strPubKey,
+		OwnerHistory:  []OwnerChange{{Owner: strPubKey, Txid: tx.Transaction.Txid, Time: tx.Transaction.Time}},
+		Validation:    v,
 	}
 
 	bir := elastic.NewBulkIndexRequest().
@@ -103,9 +105,11 @@ func intakeRecord(r *pb_oip5.RecordProto, pubKey []byte, tx *datastore.Transacti
 		Meta:   el.Meta,
 	}
 
-	recordCache.Add(el.Meta.Txid, cr)
+	if tx.GetTrace() == nil {
+		recordCache.Add(el.Meta.Txid, cr)
+	}
 
-	return bir, nil
+	return bir, cr, nil
 }
 
 func GetRecord(txid string) (*oip5Record, error) {
@@ -202,6 +206,43 @@ func GetRecordRevision(txid string) (*oip5Record, error) {
 	return rec, nil
 }
 
+// registeredPublisherName returns the name of a publisher registration contained
+// within the record, if any
+func registeredPublisherName(r *pb_oip5.RecordProto, tx *datastore.TransactionData) string {
+	pubName := ""
+	if r.Details == nil {
+		return pubName
+	}
+	for _, d := range r.Details.Details {
+		if d.TypeUrl == registeredPublisherTypeUrl {
+			regPub := &pb_templates.Tmpl_433C2783{}
+			err := ptypes.UnmarshalAny(d, regPub)
+			if err != nil {
+				log.Error("unable to decode reg pub any", logger.Attrs{"err": err, "txid": tx.Transaction.Txid})
+				continue
+			}
+			pubName = regPub.Name
+		}
+	}
+	return pubName
+}
+
+// recordTemplateNames lists the tmpl_XXXXXXXX names of all details attached to a record
+func recordTemplateNames(r *pb_oip5.RecordProto) []string {
+	var names []string
+	if r.Details == nil {
+		return names
+	}
+	for _, d := range r.Details.Details {
+		i := strings.LastIndex(d.TypeUrl, ".")
+		if i == -1 {
+			continue
+		}
+		names = append(names, d.TypeUrl[i+1:])
+	}
+	return names
+}
+
 type elasticOip5Record struct {
 	Record json.RawMessage `json:"record"`
 	Meta   RMeta           `json:"meta"`
@@ -218,14 +259,18 @@ type RMeta struct {
 	Deactivated   bool                       `json:"deactivated"`
 	SignedBy      string                     `json:"signed_by"`
 	PublisherName string                     `json:"publisher_name"`
+	Owner         string                     `json:"owner"`
+	OwnerHistory  []OwnerChange              `json:"owner_history"`
 	Time          int64                      `json:"time"`
 	Tx            *datastore.TransactionData `json:"-"`
 	Txid          string                     `json:"txid"`
 	Type          string                     `json:"type"`
 	Normalizer    int64                      `json:"normalizer_id,omitempty"`
+	Templates     []string                   `json:"templates"`
 	Latest        bool                       `json:"latest"`
 	Original      string                     `json:"original"`
 	History       []string                   `json:"history"`
 	LastModified  int64                      `json:"last_modified"`
 	RecordRaw     string                     `json:"record_raw"`
+	Validation    *Validation                `json:"validation,omitempty"`
 }
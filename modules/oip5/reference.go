@@ -0,0 +1,277 @@
+package oip5
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/azer/logger"
+	"github.com/golang/protobuf/proto"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/dynamic"
+	"github.com/oipwg/proto/go/pb_oip5"
+	"gopkg.in/olivere/elastic.v6"
+
+	"github.com/oipwg/oip/datastore"
+)
+
+const referenceIndex = "oip5_reference"
+
+// maxReferenceDepth bounds the nesting of messages searched for txid fields
+const maxReferenceDepth = 8
+
+// maxTraverseDepth and maxTraverseEdges bound the number of hops and the
+// number of edges followed per hop of a reference traversal
+const maxTraverseDepth = 5
+const maxTraverseEdges = 1000
+
+// elasticOip5Reference is an edge from a record to the record named by one of
+// the txid fields of its details
+type elasticOip5Reference struct {
+	Reference Reference     `json:"reference"`
+	Meta      ReferenceMeta `json:"meta"`
+}
+
+type Reference struct {
+	Target   string `json:"target"`
+	Template string `json:"template"`
+	// dotted path of field names from the detail to the txid field
+	Field string `json:"field"`
+}
+
+type ReferenceMeta struct {
+	// Original is the txid of the referencing record
+	Original    string `json:"original"`
+	Txid        string `json:"txid"`
+	SignedBy    string `json:"signed_by"`
+	Time        int64  `json:"time"`
+	Deactivated bool   `json:"deactivated"`
+}
+
+// extractReferences returns every txid set in the details of r, details whose
+// template is not known yet are skipped
+func extractReferences(r *pb_oip5.RecordProto) []Reference {
+	var refs []Reference
+	for _, d := range r.Details.GetDetails() {
+		i := strings.LastIndex(d.TypeUrl, ".")
+		if i == -1 {
+			continue
+		}
+		dm, err := decodeDetail(d)
+		if err != nil {
+			continue
+		}
+		refs = walkReferences(dm, d.TypeUrl[i+1:], "", 0, refs)
+	}
+	return refs
+}
+
+func walkReferences(dm *dynamic.Message, template, path string, depth int, refs []Reference) []Reference {
+	if depth > maxReferenceDepth {
+		return refs
+	}
+	for _, fd := range dm.GetMessageDescriptor().GetFields() {
+		if fd.GetMessageType() == nil || !dm.HasField(fd) {
+			continue
+		}
+		field := path + fd.GetName()
+		for _, v := range fieldMessages(fd, dm.GetField(fd)) {
+			if txid, ok := txidValue(v); ok {
+				if txid != "" {
+					refs = append(refs, Reference{Target: txid, Template: template, Field: field})
+				}
+				continue
+			}
+			nested, err := dynamic.AsDynamicMessage(v)
+			if err != nil {
+				continue
+			}
+			refs = walkReferences(nested, template, field+".", depth+1, refs)
+		}
+	}
+	return refs
+}
+
+// fieldMessages flattens the value of a message, repeated or map field
+func fieldMessages(fd *desc.FieldDescriptor, v interface{}) []proto.Message {
+	var ms []proto.Message
+	switch val := v.(type) {
+	case []interface{}:
+		for _, e := range val {
+			if m, ok := e.(proto.Message); ok {
+				ms = append(ms, m)
+			}
+		}
+	case map[interface{}]interface{}:
+		if fd.GetMapValueType().GetMessageType() == nil {
+			return nil
+		}
+		for _, e := range val {
+			if m, ok := e.(proto.Message); ok {
+				ms = append(ms, m)
+			}
+		}
+	case proto.Message:
+		ms = append(ms, val)
+	}
+	return ms
+}
+
+// referenceRequests returns the index requests of the references of rec and
+// the delete requests of those only present in prev, the record before an edit
+func referenceRequests(rec *oip5Record, prev *pb_oip5.RecordProto) []elastic.BulkableRequest {
+	var reqs []elastic.BulkableRequest
+	current := make(map[string]bool)
+	for id, ref := range referenceIds(rec.Meta.Original, extractReferences(rec.Record)) {
+		current[id] = true
+		el := elasticOip5Reference{
+			Reference: ref,
+			Meta: ReferenceMeta{
+				Original:    rec.Meta.Original,
+				Txid:        rec.Meta.Txid,
+				SignedBy:    rec.Meta.SignedBy,
+				Time:        rec.Meta.Time,
+				Deactivated: rec.Meta.Deactivated,
+			},
+		}
+		reqs = append(reqs, elastic.NewBulkIndexRequest().
+			Index(datastore.Index(referenceIndex)).
+			Type("_doc").
+			Id(id).
+			Doc(el))
+	}
+
+	if prev != nil {
+		for id := range referenceIds(rec.Meta.Original, extractReferences(prev)) {
+			if current[id] {
+				continue
+			}
+			reqs = append(reqs, elastic.NewBulkDeleteRequest().
+				Index(datastore.Index(referenceIndex)).
+				Type("_doc").
+				Id(id))
+		}
+	}
+	return reqs
+}
+
+// referenceIds keys refs by document id, numbering repeated values of a field
+func referenceIds(original string, refs []Reference) map[string]Reference {
+	ids := make(map[string]Reference, len(refs))
+	seen := make(map[string]int)
+	for _, ref := range refs {
+		key := ref.Template + "-" + ref.Field
+		ids[original+"-"+key+"-"+strconv.Itoa(seen[key])] = ref
+		seen[key]++
+	}
+	return ids
+}
+
+// indexReferences indexes the references of rec, prev is the record replaced
+// by an edit and tx is nil for records not indexed from a transaction
+func indexReferences(rec *oip5Record, prev *pb_oip5.RecordProto, tx *datastore.TransactionData) {
+	reqs := referenceRequests(rec, prev)
+	if len(reqs) == 0 {
+		return
+	}
+	if tx != nil {
+		datastore.AutoBulk.AddFor(tx, reqs...)
+	} else {
+		datastore.AutoBulk.Add(reqs...)
+	}
+}
+
+const (
+	referencesOut = "out"
+	referencesIn  = "in"
+)
+
+// referenceKey is the field of an edge naming the record it leaves (out) or reaches (in)
+func referenceKey(direction string) string {
+	if direction == referencesIn {
+		return "reference.target"
+	}
+	return "meta.original"
+}
+
+// referenceQuery matches the edges leaving (out) or reaching (in) any of ids,
+// optionally restricted to a template and field
+func referenceQuery(direction string, ids []string, template, field string) *elastic.BoolQuery {
+	values := make([]interface{}, len(ids))
+	for i, id := range ids {
+		values[i] = id
+	}
+	return referenceFilters(elastic.NewTermsQuery(referenceKey(direction), values...), template, field)
+}
+
+// referenceFilters restricts q to edges of active records, and optionally to
+// a template and field
+func referenceFilters(q elastic.Query, template, field string) *elastic.BoolQuery {
+	bq := elastic.NewBoolQuery().Must(
+		q,
+		elastic.NewTermQuery("meta.deactivated", false),
+	)
+	if template != "" {
+		bq.Must(elastic.NewTermQuery("reference.template", template))
+	}
+	if field != "" {
+		bq.Must(elastic.NewTermQuery("reference.field", field))
+	}
+	return bq
+}
+
+type referenceHop struct {
+	Depth     int                    `json:"depth"`
+	Records   []string               `json:"records"`
+	Edges     []elasticOip5Reference `json:"edges"`
+	Truncated bool                   `json:"truncated,omitempty"`
+}
+
+// traverseReferences follows references from root breadth first for up to
+// depth hops, each record is visited once and hops without edges are omitted
+func traverseReferences(ctx context.Context, root, direction string, depth int, template, field string) ([]referenceHop, error) {
+	visited := map[string]bool{root: true}
+	frontier := []string{root}
+	var hops []referenceHop
+
+	for d := 1; d <= depth && len(frontier) != 0; d++ {
+		res, err := datastore.Client().
+			Search(datastore.Index(referenceIndex)).
+			Type("_doc").
+			Query(referenceQuery(direction, frontier, template, field)).
+			Size(maxTraverseEdges).
+			Sort("meta.time", true).
+			Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		hop := referenceHop{Depth: d, Truncated: res.Hits.TotalHits > int64(len(res.Hits.Hits))}
+		frontier = nil
+		for _, h := range res.Hits.Hits {
+			var el elasticOip5Reference
+			err := json.Unmarshal(*h.Source, &el)
+			if err != nil {
+				log.Info("failed to unmarshal elastic hit", logger.Attrs{"err": err})
+				continue
+			}
+			hop.Edges = append(hop.Edges, el)
+
+			next := el.Reference.Target
+			if direction == referencesIn {
+				next = el.Meta.Original
+			}
+			if !visited[next] {
+				visited[next] = true
+				frontier = append(frontier, next)
+			}
+		}
+		if len(hop.Edges) == 0 {
+			break
+		}
+		hop.Records = frontier
+		hops = append(hops, hop)
+	}
+	return hops, nil
+}
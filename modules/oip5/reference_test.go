@@ -0,0 +1,121 @@
+package oip5
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/oipwg/proto/go/pb_oip"
+	"github.com/oipwg/proto/go/pb_oip5"
+
+	"github.com/oipwg/oip/datastore"
+	"github.com/oipwg/oip/datastore/datastoretest"
+)
+
+const (
+	playlistTemplateTxid = "c0ffee0300000000000000000000000000000000000000000000000000000000"
+	playlistTemplate     = 0xc0ffee03
+	playlistTxid         = "e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4e4"
+	secondAlbumTxid      = "b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5b5"
+)
+
+func registerPlaylistTemplate(t *testing.T) {
+	albums := field("albums", 2, descriptor.FieldDescriptorProto_LABEL_REPEATED, descriptor.FieldDescriptorProto_TYPE_MESSAGE)
+	albums.TypeName = proto.String(".oipProto.templates.P.Txid")
+	registerTemplate(t, playlistTemplateTxid, playlistTemplate,
+		field("name", 1, descriptor.FieldDescriptorProto_LABEL_OPTIONAL, descriptor.FieldDescriptorProto_TYPE_STRING),
+		albums)
+}
+
+func TestExtractReferences(t *testing.T) {
+	registerTestTemplates(t)
+	registerPlaylistTemplate(t)
+
+	cases := []struct {
+		name   string
+		record *oip5Record
+		want   []Reference
+	}{
+		{"no references", testRecord(artistTxid, detail(t, artistTemplateTxid, map[string]interface{}{"name": "Ryan"})), nil},
+		{"unset txid", testRecord(albumTxid, detail(t, albumTemplateTxid, map[string]interface{}{"title": "Songs"})), nil},
+		{"single", testRecord(albumTxid, detail(t, albumTemplateTxid, map[string]interface{}{"artist": pb_oip.TxidFromString(artistTxid)})),
+			[]Reference{{Target: artistTxid, Template: "tmpl_C0FFEE02", Field: "artist"}}},
+		{"repeated", testRecord(playlistTxid, detail(t, playlistTemplateTxid, map[string]interface{}{
+			"albums": []interface{}{pb_oip.TxidFromString(albumTxid), pb_oip.TxidFromString(secondAlbumTxid)},
+		})), []Reference{
+			{Target: albumTxid, Template: "tmpl_C0FFEE03", Field: "albums"},
+			{Target: secondAlbumTxid, Template: "tmpl_C0FFEE03", Field: "albums"},
+		}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := extractReferences(c.record.Record)
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("extractReferences() = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestIndexReferences(t *testing.T) {
+	registerTestTemplates(t)
+	registerPlaylistTemplate(t)
+
+	s := datastoretest.NewServer()
+	defer s.Close()
+	datastore.SetClient(s.Client())
+
+	album := testRecord(albumTxid, detail(t, albumTemplateTxid, map[string]interface{}{"artist": pb_oip.TxidFromString(artistTxid)}))
+	playlist := testRecord(playlistTxid, detail(t, playlistTemplateTxid, map[string]interface{}{
+		"albums": []interface{}{pb_oip.TxidFromString(albumTxid), pb_oip.TxidFromString(secondAlbumTxid)},
+	}))
+	indexReferences(album, nil, nil)
+	indexReferences(playlist, nil, nil)
+	datastore.AutoBulk.Commit()
+
+	if n := len(s.IDs(datastore.Index(referenceIndex))); n != 3 {
+		t.Fatalf("indexed %d references, want 3", n)
+	}
+
+	hops, err := traverseReferences(context.Background(), playlistTxid, referencesOut, 3, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hops) != 2 || len(hops[0].Records) != 2 || !reflect.DeepEqual(hops[1].Records, []string{artistTxid}) {
+		t.Errorf("outbound traversal = %+v", hops)
+	}
+
+	hops, err = traverseReferences(context.Background(), artistTxid, referencesIn, 2, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hops) != 2 || !reflect.DeepEqual(hops[0].Records, []string{albumTxid}) || !reflect.DeepEqual(hops[1].Records, []string{playlistTxid}) {
+		t.Errorf("inbound traversal = %+v", hops)
+	}
+
+	hops, err = traverseReferences(context.Background(), artistTxid, referencesIn, 2, "tmpl_C0FFEE03", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hops) != 0 {
+		t.Errorf("filtered traversal = %+v", hops)
+	}
+
+	// an edit dropping the second album removes its reference
+	prev := playlist.Record
+	playlist.Record = &pb_oip5.RecordProto{Details: &pb_oip5.OipDetails{Details: []*any.Any{
+		detail(t, playlistTemplateTxid, map[string]interface{}{"albums": []interface{}{pb_oip.TxidFromString(albumTxid)}}),
+	}}}
+	indexReferences(playlist, prev, nil)
+	datastore.AutoBulk.Commit()
+
+	if _, found := s.Get(datastore.Index(referenceIndex), playlistTxid+"-tmpl_C0FFEE03-albums-1"); found {
+		t.Error("reference removed by an edit still indexed")
+	}
+	if _, found := s.Get(datastore.Index(referenceIndex), playlistTxid+"-tmpl_C0FFEE03-albums-0"); !found {
+		t.Error("reference kept by an edit not indexed")
+	}
+}
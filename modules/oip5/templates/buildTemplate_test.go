@@ -0,0 +1,15 @@
+package templates
+
+import (
+	"testing"
+)
+
+// descriptor set generated by protobuf.js, see TestDescriptorFromProtobufJs
+var pbjsDescriptorSet = []byte{10, 85, 10, 27, 111, 105, 112, 53, 95, 114, 101, 99, 111, 114, 100, 95, 116, 101, 109, 112, 108, 97, 116, 101, 115, 46, 112, 114, 111, 116, 111, 18, 21, 111, 105, 112, 53, 46, 114, 101, 99, 111, 114, 100, 46, 116, 101, 109, 112, 108, 97, 116, 101, 115, 34, 23, 10, 1, 80, 18, 18, 10, 10, 102, 114, 117, 105, 116, 115, 32, 114, 114, 114, 24, 1, 32, 3, 40, 9, 98, 6, 112, 114, 111, 116, 111, 51}
+
+func TestBuildTemplateShortTxid(t *testing.T) {
+	_, err := buildTemplate(&RecordTemplate{Txid: "abc"}, pbjsDescriptorSet)
+	if err == nil {
+		t.Error("expected error for short txid")
+	}
+}
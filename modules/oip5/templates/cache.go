@@ -0,0 +1,137 @@
+package templates
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/azer/logger"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+	"gopkg.in/olivere/elastic.v6"
+
+	"github.com/oipwg/oip/datastore"
+)
+
+// templateCache holds every decoded template by identifier, templates are
+// replaced rather than modified once cached
+var templateCacheMutex sync.RWMutex
+var templateCache = make(map[uint32]*RecordTemplate)
+
+// templatePageSize is the number of templates fetched per search when loading
+var templatePageSize = 1000
+
+// missingTemplates holds identifiers recently found to have no template, or
+// whose lookup failed, until the time they may be searched for again
+var missingTemplateDepth = 1000
+var missingTemplateTTL = time.Minute
+var missingTemplates *lru.Cache
+
+func init() {
+	missingTemplates, _ = lru.New(missingTemplateDepth)
+}
+
+// CollisionError is returned for a template whose identifier, the first 8 hex
+// characters of its txid, is already held by another template
+type CollisionError struct {
+	Identifier uint32
+	Txid       string
+	Existing   string
+}
+
+func (e *CollisionError) Error() string {
+	return fmt.Sprintf("template identifier %08X of %s already used by %s", e.Identifier, e.Txid, e.Existing)
+}
+
+// identifierOf derives the 32 bit template identifier from a txid
+func identifierOf(txid string) (uint32, error) {
+	if len(txid) < 8 {
+		return 0, errors.New("invalid txid")
+	}
+	ident, err := strconv.ParseUint(txid[:8], 16, 32)
+	if err != nil {
+		return 0, errors.New("invalid txid")
+	}
+	return uint32(ident), nil
+}
+
+func cachedTemplate(ident uint32) *RecordTemplate {
+	templateCacheMutex.RLock()
+	defer templateCacheMutex.RUnlock()
+	return templateCache[ident]
+}
+
+// lookupTemplate returns the cached template for ident, loading it from the
+// datastore if it is not yet known and has not recently been missed
+func lookupTemplate(ident uint32) *RecordTemplate {
+	if tmpl := cachedTemplate(ident); tmpl != nil {
+		return tmpl
+	}
+	if until, ok := missingTemplates.Get(ident); ok && time.Now().Before(until.(time.Time)) {
+		return nil
+	}
+	tmpl, err := loadTemplate(context.TODO(), ident)
+	if err != nil {
+		log.Error("unable to load template", logger.Attrs{"err": err, "identifier": fmt.Sprintf("%08X", ident)})
+	}
+	if tmpl == nil {
+		missingTemplates.Add(ident, time.Now().Add(missingTemplateTTL))
+	}
+	return tmpl
+}
+
+// loadTemplate decodes the earliest indexed template with the identifier ident
+func loadTemplate(ctx context.Context, ident uint32) (*RecordTemplate, error) {
+	if datastore.Client() == nil {
+		return nil, nil
+	}
+	res, err := templateSearch().
+		Query(elastic.NewPrefixQuery("meta.txid", fmt.Sprintf("%08x", ident))).
+		Size(1).
+		Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Hits.Hits) == 0 {
+		return nil, nil
+	}
+	return decodeHit(res.Hits.Hits[0])
+}
+
+// templateSearch searches indexed templates in the order they were published,
+// so that the first of colliding templates is the one kept
+func templateSearch() *elastic.SearchService {
+	return datastore.Client().
+		Search(datastore.Index("oip5_templates")).
+		Type("_doc").
+		Sort("meta.block", true).
+		Sort("meta.txid", true)
+}
+
+// decodeHit decodes and caches the template stored in an elastic hit
+func decodeHit(h *elastic.SearchHit) (*RecordTemplate, error) {
+	var el elRecordTemplate
+	err := json.Unmarshal(*h.Source, &el)
+	if err != nil {
+		return nil, err
+	}
+	if el.Template == nil {
+		return nil, errors.New("missing template")
+	}
+	el.Template.SignedBy = el.Meta.SignedBy
+	el.Template.Txid = el.Meta.Txid
+
+	b, err := base64.StdEncoding.DecodeString(el.Template.FileDescriptorSet)
+	if err != nil {
+		return nil, err
+	}
+	err = DecodeDescriptorSet(el.Template, b)
+	if err != nil {
+		return nil, err
+	}
+	return el.Template, nil
+}
@@ -0,0 +1,151 @@
+package templates
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bitspill/flod/flojson"
+	"github.com/oipwg/proto/go/pb_oip5/pb_templates"
+
+	"github.com/oipwg/oip/datastore"
+	"github.com/oipwg/oip/datastore/datastoretest"
+	"github.com/oipwg/oip/trace"
+)
+
+func TestTemplateCache(t *testing.T) {
+	var (
+		first     = "a1b2c3d4" + strings.Repeat("1", 56)
+		collision = "a1b2c3d4" + strings.Repeat("2", 56)
+		other     = "0e0e0e0e" + strings.Repeat("3", 56)
+	)
+
+	s := datastoretest.NewServer()
+	defer s.Close()
+	datastore.SetClient(s.Client())
+
+	cache, pageSize := templateCache, templatePageSize
+	defer func() { templateCache, templatePageSize = cache, pageSize }()
+	templateCache = make(map[uint32]*RecordTemplate)
+	templatePageSize = 1
+	missingTemplates.Purge()
+	defer missingTemplates.Purge()
+
+	put := func(txid string, block int64) {
+		s.Put(datastore.Index("oip5_templates"), txid, elRecordTemplate{
+			Template: &RecordTemplate{FriendlyName: "Named", FileDescriptorSet: namedDescriptorSet},
+			Meta:     TMeta{Block: block, SignedBy: "FOwner", Txid: txid},
+		})
+	}
+	put(collision, 2)
+	put(first, 1)
+	put(other, 3)
+
+	err := LoadTemplatesFromES(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tmpl := cachedTemplate(0xa1b2c3d4); tmpl == nil || tmpl.Txid != first || tmpl.SignedBy != "FOwner" {
+		t.Errorf("colliding templates loaded %+v, want %s", tmpl, first)
+	}
+	if tmpl := cachedTemplate(0x0e0e0e0e); tmpl == nil || tmpl.Name != "tmpl_0E0E0E0E" {
+		t.Errorf("later page loaded %+v", tmpl)
+	}
+
+	b, err := base64.StdEncoding.DecodeString(namedDescriptorSet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = DecodeDescriptorSet(&RecordTemplate{Txid: collision}, b)
+	if ce, ok := err.(*CollisionError); !ok || ce.Existing != first {
+		t.Errorf("decoding a colliding template err = %v", err)
+	}
+	_, err = IntakeRecordTemplate(&pb_templates.RecordTemplateProto{DescriptorSetProto: b}, []byte("FOther"),
+		&datastore.TransactionData{Transaction: &flojson.TxRawResult{Txid: collision}})
+	if _, ok := err.(*CollisionError); !ok {
+		t.Errorf("intake of a colliding template err = %v", err)
+	}
+
+	// unknown identifiers are loaded on demand
+	templateCache = make(map[uint32]*RecordTemplate)
+	tmpl, err := GetTemplate(other)
+	if err != nil || tmpl == nil || tmpl.Txid != other {
+		t.Errorf("GetTemplate() = %+v, %v", tmpl, err)
+	}
+	_, err = CreateNewMessage("oipProto.templates.tmpl_A1B2C3D4")
+	if err != nil {
+		t.Error(err)
+	}
+	if tmpl := cachedTemplate(0xa1b2c3d4); tmpl == nil || tmpl.Txid != first {
+		t.Errorf("lazily loaded %+v, want %s", tmpl, first)
+	}
+	tmpl, err = GetTemplate("0badf00d")
+	if err != nil || tmpl != nil {
+		t.Errorf("GetTemplate() of an unknown template = %+v, %v", tmpl, err)
+	}
+
+	// misses are remembered until the template is decoded
+	late := "0badf00d" + strings.Repeat("4", 56)
+	put(late, 4)
+	if tmpl, _ := GetTemplate(late); tmpl != nil {
+		t.Errorf("GetTemplate() searched again after a miss, got %+v", tmpl)
+	}
+	err = DecodeDescriptorSet(&RecordTemplate{Txid: late}, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if missingTemplates.Contains(uint32(0x0badf00d)) {
+		t.Error("decoded template still recorded as missing")
+	}
+
+	// and searched for again once expired
+	delete(templateCache, 0x0badf00d)
+	missingTemplates.Add(uint32(0x0badf00d), time.Now().Add(-time.Second))
+	if tmpl, _ := GetTemplate(late); tmpl == nil || tmpl.Txid != late {
+		t.Errorf("GetTemplate() after the miss expired = %+v, want %s", tmpl, late)
+	}
+}
+
+func TestTraceTemplate(t *testing.T) {
+	var (
+		cached = "7ace7ace" + strings.Repeat("1", 56)
+		fresh  = "7ace0000" + strings.Repeat("2", 56)
+	)
+
+	cache := templateCache
+	defer func() { templateCache = cache }()
+	templateCache = make(map[uint32]*RecordTemplate)
+
+	b, err := base64.StdEncoding.DecodeString(namedDescriptorSet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edited := &RecordTemplate{Txid: cached, FriendlyName: "Edited"}
+	err = DecodeDescriptorSet(edited, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	intake := func(txid string) error {
+		_, err := IntakeRecordTemplate(&pb_templates.RecordTemplateProto{FriendlyName: "Named", DescriptorSetProto: b}, []byte("FOwner"),
+			&datastore.TransactionData{Transaction: &flojson.TxRawResult{Txid: txid}, Trace: trace.New(txid)})
+		return err
+	}
+	if err := intake(cached); err != nil {
+		t.Error(err)
+	}
+	if tmpl := cachedTemplate(0x7ace7ace); tmpl != edited {
+		t.Errorf("traced template replaced the cached one with %+v", tmpl)
+	}
+	if err := intake(fresh); err != nil {
+		t.Error(err)
+	}
+	if tmpl := cachedTemplate(0x7ace0000); tmpl != nil {
+		t.Errorf("traced template cached %+v", tmpl)
+	}
+	if TemplateMessageFactory.GetKnownTypeRegistry().GetKnownType("oipProto.templates.tmpl_7ACE0000") != nil {
+		t.Error("traced template types registered")
+	}
+}
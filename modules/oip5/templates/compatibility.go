@@ -0,0 +1,161 @@
+package templates
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/jhump/protoreflect/desc"
+)
+
+// IncompatibleError is returned when an edited template would no longer
+// decode records published with the previous version
+type IncompatibleError struct {
+	Reasons []string
+}
+
+func (e *IncompatibleError) Error() string {
+	return "incompatible template edit: " + strings.Join(e.Reasons, "; ")
+}
+
+// CheckCompatibility compares a template with its edited version. Fields and
+// enum values may be added, or removed provided their number is reserved, but
+// a number may not change its name or type nor be reused.
+func CheckCompatibility(prev, next *desc.MessageDescriptor) error {
+	c := compatibility{visited: make(map[string]bool)}
+	c.message(prev, next, "")
+	if len(c.reasons) != 0 {
+		return &IncompatibleError{Reasons: c.reasons}
+	}
+	return nil
+}
+
+type compatibility struct {
+	reasons []string
+	visited map[string]bool
+}
+
+func (c *compatibility) reject(format string, args ...interface{}) {
+	c.reasons = append(c.reasons, fmt.Sprintf(format, args...))
+}
+
+func (c *compatibility) message(prev, next *desc.MessageDescriptor, path string) {
+	if c.visited[prev.GetFullyQualifiedName()] {
+		return
+	}
+	c.visited[prev.GetFullyQualifiedName()] = true
+
+	nextProto := next.AsDescriptorProto()
+	for _, of := range prev.GetFields() {
+		name := path + of.GetName()
+		nf := next.FindFieldByNumber(of.GetNumber())
+		if nf == nil {
+			if !messageReserved(nextProto, of.GetNumber()) {
+				c.reject("field %s (%d) removed without reserving its number", name, of.GetNumber())
+			}
+			continue
+		}
+		if nf.GetName() != of.GetName() {
+			c.reject("field number %d reused by %s, previously %s", of.GetNumber(), path+nf.GetName(), name)
+			continue
+		}
+		c.field(of, nf, name)
+	}
+
+	prevProto := prev.AsDescriptorProto()
+	for _, nf := range next.GetFields() {
+		if prev.FindFieldByNumber(nf.GetNumber()) != nil {
+			continue
+		}
+		if messageReserved(prevProto, nf.GetNumber()) {
+			c.reject("field %s reuses reserved number %d", path+nf.GetName(), nf.GetNumber())
+		}
+		for _, rn := range prevProto.GetReservedName() {
+			if rn == nf.GetName() {
+				c.reject("field %s reuses a reserved name", path+nf.GetName())
+			}
+		}
+	}
+}
+
+func (c *compatibility) field(of, nf *desc.FieldDescriptor, name string) {
+	if of.GetType() != nf.GetType() {
+		c.reject("field %s changed type from %s to %s", name, typeName(of), typeName(nf))
+		return
+	}
+	if of.IsRepeated() != nf.IsRepeated() || of.IsMap() != nf.IsMap() {
+		c.reject("field %s changed cardinality", name)
+		return
+	}
+
+	switch of.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE, descriptor.FieldDescriptorProto_TYPE_GROUP:
+		om, nm := of.GetMessageType(), nf.GetMessageType()
+		if isTxid(om) || isTxid(nm) {
+			if isTxid(om) != isTxid(nm) {
+				c.reject("field %s changed type from %s to %s", name, typeName(of), typeName(nf))
+			}
+			return
+		}
+		c.message(om, nm, name+".")
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		c.enum(of.GetEnumType(), nf.GetEnumType(), name)
+	}
+}
+
+func (c *compatibility) enum(prev, next *desc.EnumDescriptor, name string) {
+	nextProto := next.AsEnumDescriptorProto()
+	for _, ov := range prev.GetValues() {
+		nv := next.FindValueByNumber(ov.GetNumber())
+		if nv == nil {
+			if !enumReserved(nextProto, ov.GetNumber()) {
+				c.reject("enum value %s (%d) of field %s removed without reserving its number", ov.GetName(), ov.GetNumber(), name)
+			}
+			continue
+		}
+		if nv.GetName() != ov.GetName() {
+			c.reject("enum value number %d of field %s reused by %s, previously %s", ov.GetNumber(), name, nv.GetName(), ov.GetName())
+		}
+	}
+
+	prevProto := prev.AsEnumDescriptorProto()
+	for _, nv := range next.GetValues() {
+		if prev.FindValueByNumber(nv.GetNumber()) == nil && enumReserved(prevProto, nv.GetNumber()) {
+			c.reject("enum value %s of field %s reuses reserved number %d", nv.GetName(), name, nv.GetNumber())
+		}
+	}
+}
+
+func isTxid(md *desc.MessageDescriptor) bool {
+	return md.GetFullyQualifiedName() == "oipProto.Txid"
+}
+
+func typeName(fd *desc.FieldDescriptor) string {
+	switch {
+	case fd.GetMessageType() != nil:
+		return fd.GetMessageType().GetFullyQualifiedName()
+	case fd.GetEnumType() != nil:
+		return "enum"
+	}
+	return strings.ToLower(strings.TrimPrefix(fd.GetType().String(), "TYPE_"))
+}
+
+// messageReserved reports whether number is reserved, message reserved range ends are exclusive
+func messageReserved(md *descriptor.DescriptorProto, number int32) bool {
+	for _, r := range md.GetReservedRange() {
+		if number >= r.GetStart() && number < r.GetEnd() {
+			return true
+		}
+	}
+	return false
+}
+
+// enumReserved reports whether number is reserved, enum reserved range ends are inclusive
+func enumReserved(ed *descriptor.EnumDescriptorProto, number int32) bool {
+	for _, r := range ed.GetReservedRange() {
+		if number >= r.GetStart() && number <= r.GetEnd() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,131 @@
+package templates
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/jhump/protoreflect/desc"
+)
+
+func scalar(name string, number int32, typ descriptor.FieldDescriptorProto_Type) *descriptor.FieldDescriptorProto {
+	return &descriptor.FieldDescriptorProto{
+		Name:   proto.String(name),
+		Number: proto.Int32(number),
+		Label:  descriptor.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:   typ.Enum(),
+	}
+}
+
+func enumField(name string, number int32) *descriptor.FieldDescriptorProto {
+	f := scalar(name, number, descriptor.FieldDescriptorProto_TYPE_ENUM)
+	f.TypeName = proto.String(".oipProto.templates.P.Options")
+	return f
+}
+
+func options(values ...string) *descriptor.EnumDescriptorProto {
+	e := &descriptor.EnumDescriptorProto{Name: proto.String("Options")}
+	for i, v := range values {
+		if v != "" {
+			e.Value = append(e.Value, &descriptor.EnumValueDescriptorProto{Name: proto.String(v), Number: proto.Int32(int32(i))})
+		}
+	}
+	return e
+}
+
+func message(t *testing.T, m *descriptor.DescriptorProto) *desc.MessageDescriptor {
+	t.Helper()
+	m.Name = proto.String("P")
+	fd, err := desc.CreateFileDescriptor(&descriptor.FileDescriptorProto{
+		Name:        proto.String("p.proto"),
+		Package:     proto.String("oipProto.templates"),
+		Syntax:      proto.String("proto3"),
+		MessageType: []*descriptor.DescriptorProto{m},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fd.FindMessage("oipProto.templates.P")
+}
+
+func TestCheckCompatibility(t *testing.T) {
+	text := scalar("text", 1, descriptor.FieldDescriptorProto_TYPE_STRING)
+	count := scalar("count", 2, descriptor.FieldDescriptorProto_TYPE_INT64)
+	prev := &descriptor.DescriptorProto{
+		Field:         []*descriptor.FieldDescriptorProto{text, count, enumField("option", 3)},
+		EnumType:      []*descriptor.EnumDescriptorProto{options("NONE", "ONE", "TWO")},
+		ReservedRange: []*descriptor.DescriptorProto_ReservedRange{{Start: proto.Int32(9), End: proto.Int32(10)}},
+		ReservedName:  []string{"gone"},
+	}
+
+	cases := []struct {
+		name       string
+		next       *descriptor.DescriptorProto
+		compatible bool
+	}{
+		{"unchanged", prev, true},
+		{"field added", &descriptor.DescriptorProto{
+			Field:    []*descriptor.FieldDescriptorProto{text, count, enumField("option", 3), scalar("extra", 4, descriptor.FieldDescriptorProto_TYPE_BOOL)},
+			EnumType: []*descriptor.EnumDescriptorProto{options("NONE", "ONE", "TWO", "THREE")},
+		}, true},
+		{"field removed and reserved", &descriptor.DescriptorProto{
+			Field:         []*descriptor.FieldDescriptorProto{text, enumField("option", 3)},
+			EnumType:      []*descriptor.EnumDescriptorProto{options("NONE", "ONE", "TWO")},
+			ReservedRange: []*descriptor.DescriptorProto_ReservedRange{{Start: proto.Int32(2), End: proto.Int32(3)}},
+		}, true},
+		{"field removed", &descriptor.DescriptorProto{
+			Field:    []*descriptor.FieldDescriptorProto{text, enumField("option", 3)},
+			EnumType: []*descriptor.EnumDescriptorProto{options("NONE", "ONE", "TWO")},
+		}, false},
+		{"field renumbered", &descriptor.DescriptorProto{
+			Field:    []*descriptor.FieldDescriptorProto{text, scalar("count", 4, descriptor.FieldDescriptorProto_TYPE_INT64), enumField("option", 3)},
+			EnumType: []*descriptor.EnumDescriptorProto{options("NONE", "ONE", "TWO")},
+		}, false},
+		{"number reused", &descriptor.DescriptorProto{
+			Field:    []*descriptor.FieldDescriptorProto{text, scalar("total", 2, descriptor.FieldDescriptorProto_TYPE_INT64), enumField("option", 3)},
+			EnumType: []*descriptor.EnumDescriptorProto{options("NONE", "ONE", "TWO")},
+		}, false},
+		{"type changed", &descriptor.DescriptorProto{
+			Field:    []*descriptor.FieldDescriptorProto{text, scalar("count", 2, descriptor.FieldDescriptorProto_TYPE_STRING), enumField("option", 3)},
+			EnumType: []*descriptor.EnumDescriptorProto{options("NONE", "ONE", "TWO")},
+		}, false},
+		{"made repeated", &descriptor.DescriptorProto{
+			Field: []*descriptor.FieldDescriptorProto{text, {
+				Name:   proto.String("count"),
+				Number: proto.Int32(2),
+				Label:  descriptor.FieldDescriptorProto_LABEL_REPEATED.Enum(),
+				Type:   descriptor.FieldDescriptorProto_TYPE_INT64.Enum(),
+			}, enumField("option", 3)},
+			EnumType: []*descriptor.EnumDescriptorProto{options("NONE", "ONE", "TWO")},
+		}, false},
+		{"reserved number reused", &descriptor.DescriptorProto{
+			Field:    []*descriptor.FieldDescriptorProto{text, count, enumField("option", 3), scalar("extra", 9, descriptor.FieldDescriptorProto_TYPE_BOOL)},
+			EnumType: []*descriptor.EnumDescriptorProto{options("NONE", "ONE", "TWO")},
+		}, false},
+		{"reserved name reused", &descriptor.DescriptorProto{
+			Field:    []*descriptor.FieldDescriptorProto{text, count, enumField("option", 3), scalar("gone", 4, descriptor.FieldDescriptorProto_TYPE_BOOL)},
+			EnumType: []*descriptor.EnumDescriptorProto{options("NONE", "ONE", "TWO")},
+		}, false},
+		{"enum value removed", &descriptor.DescriptorProto{
+			Field:    []*descriptor.FieldDescriptorProto{text, count, enumField("option", 3)},
+			EnumType: []*descriptor.EnumDescriptorProto{options("NONE", "ONE")},
+		}, false},
+		{"enum value renamed", &descriptor.DescriptorProto{
+			Field:    []*descriptor.FieldDescriptorProto{text, count, enumField("option", 3)},
+			EnumType: []*descriptor.EnumDescriptorProto{options("NONE", "ONE", "DOS")},
+		}, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := CheckCompatibility(message(t, prev), message(t, c.next))
+			if (err == nil) != c.compatible {
+				t.Errorf("CheckCompatibility() = %v, want compatible %v", err, c.compatible)
+			}
+			if err != nil {
+				if _, ok := err.(*IncompatibleError); !ok {
+					t.Errorf("unexpected error type %T", err)
+				}
+			}
+		})
+	}
+}
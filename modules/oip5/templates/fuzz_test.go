@@ -0,0 +1,21 @@
+//go:build go1.18
+// +build go1.18
+
+package templates
+
+import (
+	"testing"
+)
+
+// buildTemplate is fuzzed directly rather than through DecodeDescriptorSet as
+// the latter recovers from panics and registers the types it builds
+func FuzzBuildTemplate(f *testing.F) {
+	f.Add(pbjsDescriptorSet)
+	f.Fuzz(func(t *testing.T, b []byte) {
+		rt := &RecordTemplate{Txid: "000000000badbabe"}
+		_, err := buildTemplate(rt, b)
+		if err == nil && rt.MessageDescriptor == nil {
+			t.Error("template built without a message descriptor")
+		}
+	})
+}
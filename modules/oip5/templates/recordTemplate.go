@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
-	"reflect"
 	"strconv"
 	"strings"
 
@@ -30,14 +29,13 @@ func IntakeRecordTemplate(rt *pb_templates.RecordTemplateProto, pubKey []byte, t
 		log.Error("invalid txid", attr)
 		return nil, errors.New("invalid txid")
 	}
-	strIdent := tx.Transaction.Txid[:8]
-	ident, err := strconv.ParseUint(strIdent, 16, 32)
+	ident, err := identifierOf(tx.Transaction.Txid)
 	if err != nil {
 		attr["err"] = err
 		log.Error("unable to decode txid", attr)
 		return nil, errors.New("unable to decode txid")
 	}
-	rt.Identifier = uint32(ident)
+	rt.Identifier = ident
 
 	tmpl := &RecordTemplate{
 		Txid:              tx.Transaction.Txid,
@@ -49,15 +47,25 @@ func IntakeRecordTemplate(rt *pb_templates.RecordTemplateProto, pubKey []byte, t
 		FileDescriptorSet: base64.StdEncoding.EncodeToString(rt.DescriptorSetProto),
 	}
 
-	err = DecodeDescriptorSet(tmpl, rt.DescriptorSetProto)
+	// collisions are checked against the cache alone, which LoadTemplatesFromES
+	// fills with every indexed template, rather than searching on each intake
+	if tx.GetTrace() != nil {
+		// a traced template must not replace the cached one or its edits
+		err = checkDescriptorSet(tmpl, rt.DescriptorSetProto)
+	} else {
+		err = DecodeDescriptorSet(tmpl, rt.DescriptorSetProto)
+	}
 	if err != nil {
 		attr["err"] = err
 		log.Error("unable to decode descriptor set", attr)
+		if ce, ok := err.(*CollisionError); ok {
+			return nil, ce
+		}
 		return nil, errors.New("unable to decode descriptor set")
 	}
 
 	elRt := elRecordTemplate{
-		Template: templateCache[uint32(ident)],
+		Template: tmpl,
 		Meta: TMeta{
 			SignedBy:  string(pubKey),
 			Tx:        tx,
@@ -77,32 +85,83 @@ func IntakeRecordTemplate(rt *pb_templates.RecordTemplateProto, pubKey []byte, t
 	return bir, nil
 }
 
-func DecodeDescriptorSet(rt *RecordTemplate, descriptorSetProto []byte) (err error) {
+func DecodeDescriptorSet(rt *RecordTemplate, descriptorSetProto []byte) error {
+	return decodeDescriptorSet(rt, descriptorSetProto, true)
+}
+
+// checkDescriptorSet decodes a template as DecodeDescriptorSet does without
+// caching it or registering its types
+func checkDescriptorSet(rt *RecordTemplate, descriptorSetProto []byte) error {
+	return decodeDescriptorSet(rt, descriptorSetProto, false)
+}
+
+func decodeDescriptorSet(rt *RecordTemplate, descriptorSetProto []byte, register bool) (err error) {
 	defer func() {
 		if r := recover(); r != nil {
 			err = fmt.Errorf("panic within DecodeDescriptorSet %s", r)
 		}
 	}()
 
+	ident, err := identifierOf(rt.Txid)
+	if err != nil {
+		return err
+	}
+
+	// held throughout so that a colliding template never registers its types
+	templateCacheMutex.Lock()
+	defer templateCacheMutex.Unlock()
+
+	if existing := templateCache[ident]; existing != nil && existing.Txid != rt.Txid {
+		return &CollisionError{Identifier: ident, Txid: rt.Txid, Existing: existing.Txid}
+	}
+
+	file, err := buildTemplate(rt, descriptorSetProto)
+	if err != nil {
+		return err
+	}
+	if !register {
+		rt.Identifier = ident
+		return nil
+	}
+
+	// only register the types once the template is known to be valid
+	for _, fileMsgType := range file.GetMessageTypes() {
+		addProtoType(fileMsgType, rt.Txid)
+	}
+
+	rt.Identifier = ident
+	templateCache[ident] = rt
+	missingTemplates.Remove(ident)
+	return nil
+}
+
+// buildTemplate decodes the descriptor set of a template, renaming the message
+// after the template txid, and sets the resulting descriptor on rt
+func buildTemplate(rt *RecordTemplate, descriptorSetProto []byte) (*desc.FileDescriptor, error) {
 	attr := logger.Attrs{"txid": rt.Txid}
+	if len(rt.Txid) < 8 {
+		log.Error("invalid template txid", attr)
+		return nil, errors.New("invalid template txid")
+	}
+
 	var dsp = &descriptor.FileDescriptorSet{}
-	err = proto.Unmarshal(descriptorSetProto, dsp)
+	err := proto.Unmarshal(descriptorSetProto, dsp)
 	if err != nil {
 		attr["err"] = err
 		log.Error("unable to unmarshal template descriptor", attr)
-		return errors.New("unable to unmarshal template descriptor")
+		return nil, errors.New("unable to unmarshal template descriptor")
 	}
 	fd, err := desc.CreateFileDescriptorFromSet(dsp)
 	if err != nil {
 		attr["err"] = err
 		log.Error("unable to create file descriptor", attr)
-		return errors.New("unable to create file descriptor")
+		return nil, errors.New("unable to create file descriptor")
 	}
 	fileBuilder, err := builder.FromFile(fd)
 	if err != nil {
 		attr["err"] = err
 		log.Error("unable to create builder", attr)
-		return errors.New("unable to create builder")
+		return nil, errors.New("unable to create builder")
 	}
 	newName := "tmpl_" + strings.ToUpper(rt.Txid[:8])
 	err = fileBuilder.TrySetName(newName + ".proto")
@@ -111,12 +170,12 @@ func DecodeDescriptorSet(rt *RecordTemplate, descriptorSetProto []byte) (err err
 		attr["newName"] = newName + ".proto"
 		attr["oldName"] = fileBuilder.GetName()
 		log.Error("unable to set file name", attr)
-		return errors.New("unable to set file name")
+		return nil, errors.New("unable to set file name")
 	}
 	messageBuilder := fileBuilder.GetMessage("P")
 	if messageBuilder == nil {
 		log.Error("unable to find message oipProto.templates.P", attr)
-		return errors.New("unable to find message oipProto.templates.P")
+		return nil, errors.New("unable to find message oipProto.templates.P")
 	}
 	err = messageBuilder.TrySetName(newName)
 	if err != nil {
@@ -124,7 +183,7 @@ func DecodeDescriptorSet(rt *RecordTemplate, descriptorSetProto []byte) (err err
 		attr["newName"] = newName
 		attr["oldName"] = messageBuilder.GetName()
 		log.Error("unable to set message name", attr)
-		return errors.New("unable to set message name")
+		return nil, errors.New("unable to set message name")
 	}
 
 	children := messageBuilder.GetChildren()
@@ -138,13 +197,13 @@ func DecodeDescriptorSet(rt *RecordTemplate, descriptorSetProto []byte) (err err
 					if err != nil {
 						attr["err"] = err
 						log.Error("unable to load txid descriptor", attr)
-						return err
+						return nil, err
 					}
 					txidMessageType, err := builder.FromMessage(txidDescriptor)
 					if err != nil {
 						attr["err"] = err
 						log.Error("unable to create txid message type", attr)
-						return err
+						return nil, err
 					}
 					fb.SetType(builder.FieldTypeMessage(txidMessageType))
 					ok := messageBuilder.TryRemoveField(fb.GetName())
@@ -153,11 +212,11 @@ func DecodeDescriptorSet(rt *RecordTemplate, descriptorSetProto []byte) (err err
 						if err != nil {
 							attr["err"] = err
 							log.Error("unable to add txid field", attr)
-							return err
+							return nil, err
 						}
 					} else {
 						log.Error("unable to remove txid field", attr)
-						return errors.New("unable to remove txid field")
+						return nil, errors.New("unable to remove txid field")
 					}
 				}
 			}
@@ -167,7 +226,7 @@ func DecodeDescriptorSet(rt *RecordTemplate, descriptorSetProto []byte) (err err
 				ok := messageBuilder.TryRemoveNestedMessage("Txid")
 				if !ok {
 					log.Error("unable to remove nested Txid Type", attr)
-					return errors.New("unable to remove nested Txid Type")
+					return nil, errors.New("unable to remove nested Txid Type")
 				}
 			}
 		}
@@ -177,22 +236,19 @@ func DecodeDescriptorSet(rt *RecordTemplate, descriptorSetProto []byte) (err err
 	if err != nil {
 		attr["err"] = err
 		log.Error("unable to build message descriptor", attr)
-		return errors.New("unable to build message descriptor")
+		return nil, errors.New("unable to build message descriptor")
 	}
 	file, err := fileBuilder.Build()
 	if err != nil {
 		attr["err"] = err
 		log.Error("unable to build file descriptor", attr)
-		return errors.New("unable to build file descriptor")
-	}
-	for _, fileMsgType := range file.GetMessageTypes() {
-		addProtoType(fileMsgType, rt.Txid)
+		return nil, errors.New("unable to build file descriptor")
 	}
 
 	if !strings.HasPrefix(message.GetFullyQualifiedName(), "oipProto.templates.") {
 		attr["fqn"] = message.GetFullyQualifiedName()
 		log.Error("missing required package", attr)
-		return errors.New("missing required package")
+		return nil, errors.New("missing required package")
 	}
 
 	rt.MessageDescriptor = message
@@ -201,8 +257,7 @@ func DecodeDescriptorSet(rt *RecordTemplate, descriptorSetProto []byte) (err err
 
 	// rt.MessageType = TemplateMessageFactory.GetKnownTypeRegistry().GetKnownType(message.GetFullyQualifiedName())
 
-	templateCache[rt.Identifier] = rt
-	return nil
+	return file, nil
 }
 
 func addProtoType(fileMsgType *desc.MessageDescriptor, txid string) {
@@ -217,7 +272,6 @@ func addProtoType(fileMsgType *desc.MessageDescriptor, txid string) {
 	ktr.AddKnownType(dynamic.NewMessageWithMessageFactory(fileMsgType, TemplateMessageFactory))
 }
 
-var templateCache = make(map[uint32]*RecordTemplate)
 var TemplateMessageFactory = dynamic.NewMessageFactoryWithDefaults()
 
 // var TemplateAnyResolver = anyResolver{upstreamAny: dynamic.AnyResolver(TemplateMessageFactory)}
@@ -264,14 +318,16 @@ func CreateNewMessage(id string) (proto.Message, error) {
 	if len(hexId) == 8 {
 		ident, err := strconv.ParseUint(hexId, 16, 32)
 		if err == nil {
-			if t, ok := templateCache[uint32(ident)]; ok {
+			if t := lookupTemplate(uint32(ident)); t != nil {
 				msg := dynamic.NewMessageWithMessageFactory(t.MessageDescriptor, TemplateMessageFactory)
 				return msg, nil
 			}
 		}
 	}
 
+	templateCacheMutex.RLock()
 	m := TemplateMessageFactory.GetKnownTypeRegistry().CreateIfKnown(id)
+	templateCacheMutex.RUnlock()
 	if m == nil {
 		return nil, fmt.Errorf("unknown message type %q", id)
 	}
@@ -285,50 +341,54 @@ func CreateNewMessage(id string) (proto.Message, error) {
 	return m, nil
 }
 
+// LoadTemplatesFromES fills the template cache with every indexed template,
+// keeping the earliest of any whose identifiers collide
 func LoadTemplatesFromES(ctx context.Context) error {
-	searchService := datastore.Client().Search(datastore.Index("oip5_templates")).Type("_doc").Size(10000)
-
-	res, err := searchService.Do(ctx)
-	if err != nil {
-		return err
-	}
-
-	templates := res.Each(reflect.TypeOf(elRecordTemplate{}))
-
-	for _, value := range templates {
-		tmpl := value.(elRecordTemplate)
-		tmpl.Template.SignedBy = tmpl.Meta.SignedBy
-		tmpl.Template.Txid = tmpl.Meta.Txid
-
-		b, err := base64.StdEncoding.DecodeString(tmpl.Template.FileDescriptorSet)
-		if err != nil {
-			return err
+	var after []interface{}
+	for {
+		search := templateSearch().Size(templatePageSize)
+		if after != nil {
+			search.SearchAfter(after...)
 		}
-		err = DecodeDescriptorSet(tmpl.Template, b)
+		res, err := search.Do(ctx)
 		if err != nil {
 			return err
 		}
-	}
 
-	return nil
+		for _, h := range res.Hits.Hits {
+			_, err := decodeHit(h)
+			if ce, ok := err.(*CollisionError); ok {
+				log.Error("template identifier collision", logger.Attrs{"err": ce, "txid": ce.Txid})
+				continue
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(res.Hits.Hits) < templatePageSize {
+			return nil
+		}
+		after = res.Hits.Hits[len(res.Hits.Hits)-1].Sort
+	}
 }
 
 func GetTemplate(txid string) (*RecordTemplate, error) {
-	if len(txid) < 8 {
-		log.Error("invalid txid", logger.Attrs{"txid": txid})
-		return nil, errors.New("invalid txid")
-	}
-	strIdent := txid[:8]
-	ident, err := strconv.ParseUint(strIdent, 16, 32)
+	ident, err := identifierOf(txid)
 	if err != nil {
 		log.Error("invalid txid", logger.Attrs{"txid": txid})
-		return nil, errors.New("invalid txid")
+		return nil, err
 	}
-	tmpl := templateCache[uint32(ident)]
-	return tmpl, nil
+	return lookupTemplate(ident), nil
 }
 
-func EditTemplate(tmpl *RecordTemplate, newRaw string, editTxid string) error {
+func EditTemplate(tmpl *RecordTemplate, newRaw string, editTxid string) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic within EditTemplate %s", r)
+		}
+	}()
+
 	b, err := base64.StdEncoding.DecodeString(newRaw)
 	if err != nil {
 		return errors.New("unable to decode raw template")
@@ -340,26 +400,40 @@ func EditTemplate(tmpl *RecordTemplate, newRaw string, editTxid string) error {
 		return errors.New("unable to decode template proto for edit")
 	}
 
+	next := &RecordTemplate{Txid: tmpl.Txid}
+	_, err = buildTemplate(next, newVal.DescriptorSetProto)
+	if err != nil {
+		return errors.New("unable to decode descriptor set")
+	}
+	if tmpl.MessageDescriptor != nil {
+		err = CheckCompatibility(tmpl.MessageDescriptor, next.MessageDescriptor)
+		if err != nil {
+			return err
+		}
+	}
+
+	// edited as a copy which replaces tmpl in the cache, as readers may hold it
+	updated := *tmpl
 	if newVal.FriendlyName != "" {
-		tmpl.FriendlyName = newVal.FriendlyName
+		updated.FriendlyName = newVal.FriendlyName
 	}
 	if newVal.Description != "" {
-		tmpl.Description = newVal.Description
+		updated.Description = newVal.Description
 	}
 	if newVal.Extends != nil {
-		tmpl.Extends = newVal.Extends
+		updated.Extends = newVal.Extends
 	}
 
-	tmpl.FileDescriptorSet = base64.StdEncoding.EncodeToString(newVal.DescriptorSetProto)
+	updated.FileDescriptorSet = base64.StdEncoding.EncodeToString(newVal.DescriptorSetProto)
 
-	err = DecodeDescriptorSet(tmpl, newVal.DescriptorSetProto)
+	err = DecodeDescriptorSet(&updated, newVal.DescriptorSetProto)
 	if err != nil {
 		log.Error("unable to decode descriptor set", tmpl.Name)
 		return errors.New("unable to decode descriptor set")
 	}
 
 	elRt := elRecordTemplate{
-		Template: tmpl,
+		Template: &updated,
 	}
 
 	bir := elastic.NewBulkUpdateRequest().
@@ -0,0 +1,194 @@
+package templates
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/protoprint"
+)
+
+// ProtoSource renders the file defining the template as .proto source, with
+// the message named after the template
+func (rt *RecordTemplate) ProtoSource() (string, error) {
+	if rt.MessageDescriptor == nil {
+		return "", fmt.Errorf("template %s has no descriptor", rt.Name)
+	}
+	var buf bytes.Buffer
+	p := protoprint.Printer{}
+	err := p.PrintProtoFile(rt.MessageDescriptor.GetFile(), &buf)
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// JSONSchema describes, as a draft-07 JSON Schema, the json form of a record
+// using the template along with the templates it extends
+func (rt *RecordTemplate) JSONSchema() map[string]interface{} {
+	s := &schemaBuilder{definitions: make(map[string]interface{})}
+
+	details := make(map[string]interface{})
+	var required []string
+	visited := make(map[uint32]bool)
+	queue := []uint32{rt.Identifier}
+	for len(queue) != 0 {
+		ident := queue[0]
+		queue = queue[1:]
+		if visited[ident] {
+			continue
+		}
+		visited[ident] = true
+
+		name := fmt.Sprintf("tmpl_%08X", ident)
+		required = append(required, name)
+		tmpl := rt
+		if ident != rt.Identifier {
+			tmpl = lookupTemplate(ident)
+		}
+		if tmpl == nil || tmpl.MessageDescriptor == nil {
+			details[name] = map[string]interface{}{"description": "template not indexed"}
+			continue
+		}
+		details[name] = s.ref(tmpl.MessageDescriptor)
+		queue = append(queue, tmpl.Extends...)
+	}
+
+	schema := map[string]interface{}{
+		"$schema": "http://json-schema.org/draft-07/schema#",
+		"title":   rt.FriendlyName,
+		"type":    "object",
+		"properties": map[string]interface{}{
+			"details": map[string]interface{}{
+				"type":       "object",
+				"properties": details,
+				"required":   required,
+			},
+		},
+		"required":    []string{"details"},
+		"definitions": s.definitions,
+	}
+	if rt.Description != "" {
+		schema["description"] = rt.Description
+	}
+	return schema
+}
+
+type schemaBuilder struct {
+	definitions map[string]interface{}
+}
+
+// ref returns a reference to the definition of md, adding it if needed, or
+// the json form of a well-known type
+func (s *schemaBuilder) ref(md *desc.MessageDescriptor) map[string]interface{} {
+	name := md.GetFullyQualifiedName()
+	if wk := wellKnown(name); wk != nil {
+		return wk
+	}
+	if _, ok := s.definitions[name]; !ok {
+		// placeholder so that recursive messages terminate
+		s.definitions[name] = nil
+		s.definitions[name] = s.message(md)
+	}
+	return map[string]interface{}{"$ref": "#/definitions/" + name}
+}
+
+// wellKnown describes the well-known type named name as rendered by jsonpb, or
+// returns nil for any other message
+func wellKnown(name string) map[string]interface{} {
+	switch name {
+	case "google.protobuf.Timestamp":
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	case "google.protobuf.Duration":
+		return map[string]interface{}{"type": "string", "pattern": "^-?[0-9]+(\\.[0-9]+)?s$"}
+	case "google.protobuf.FieldMask":
+		return map[string]interface{}{"type": "string"}
+	case "google.protobuf.Struct", "google.protobuf.Empty":
+		return map[string]interface{}{"type": "object"}
+	case "google.protobuf.Value":
+		return map[string]interface{}{}
+	case "google.protobuf.ListValue":
+		return map[string]interface{}{"type": "array"}
+	case "google.protobuf.Any":
+		return map[string]interface{}{
+			"type":       "object",
+			"properties": map[string]interface{}{"@type": map[string]interface{}{"type": "string"}},
+			"required":   []string{"@type"},
+		}
+	case "google.protobuf.BoolValue":
+		return map[string]interface{}{"type": "boolean"}
+	case "google.protobuf.StringValue":
+		return map[string]interface{}{"type": "string"}
+	case "google.protobuf.BytesValue":
+		return map[string]interface{}{"type": "string", "contentEncoding": "base64"}
+	case "google.protobuf.FloatValue", "google.protobuf.DoubleValue":
+		return map[string]interface{}{"type": "number"}
+	case "google.protobuf.Int32Value", "google.protobuf.UInt32Value":
+		return map[string]interface{}{"type": "integer"}
+	case "google.protobuf.Int64Value", "google.protobuf.UInt64Value":
+		// jsonpb quotes 64 bit integers
+		return map[string]interface{}{"type": "string", "pattern": "^-?[0-9]+$"}
+	}
+	return nil
+}
+
+func (s *schemaBuilder) message(md *desc.MessageDescriptor) map[string]interface{} {
+	properties := make(map[string]interface{})
+	for _, fd := range md.GetFields() {
+		properties[fd.GetJSONName()] = s.field(fd)
+	}
+	return map[string]interface{}{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+}
+
+func (s *schemaBuilder) field(fd *desc.FieldDescriptor) map[string]interface{} {
+	if fd.IsMap() {
+		return map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": s.value(fd.GetMapValueType()),
+		}
+	}
+	if fd.IsRepeated() {
+		return map[string]interface{}{
+			"type":  "array",
+			"items": s.value(fd),
+		}
+	}
+	return s.value(fd)
+}
+
+// value describes a single value of fd as rendered by jsonpb
+func (s *schemaBuilder) value(fd *desc.FieldDescriptor) map[string]interface{} {
+	switch fd.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		return map[string]interface{}{"type": "boolean"}
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		return map[string]interface{}{"type": "string"}
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		return map[string]interface{}{"type": "string", "contentEncoding": "base64"}
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT, descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		return map[string]interface{}{"type": "number"}
+	case descriptor.FieldDescriptorProto_TYPE_INT32, descriptor.FieldDescriptorProto_TYPE_SINT32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32, descriptor.FieldDescriptorProto_TYPE_UINT32,
+		descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		return map[string]interface{}{"type": "integer"}
+	case descriptor.FieldDescriptorProto_TYPE_INT64, descriptor.FieldDescriptorProto_TYPE_SINT64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64, descriptor.FieldDescriptorProto_TYPE_UINT64,
+		descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		// jsonpb quotes 64 bit integers
+		return map[string]interface{}{"type": "string", "pattern": "^-?[0-9]+$"}
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		var names []string
+		for _, v := range fd.GetEnumType().GetValues() {
+			names = append(names, v.GetName())
+		}
+		return map[string]interface{}{"type": "string", "enum": names}
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE, descriptor.FieldDescriptorProto_TYPE_GROUP:
+		return s.ref(fd.GetMessageType())
+	}
+	return map[string]interface{}{}
+}
@@ -0,0 +1,136 @@
+package templates
+
+import (
+	"encoding/base64"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/ptypes/struct"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/jhump/protoreflect/desc"
+)
+
+// message P { string name = 1; string floBip44XPub = 2; } as built by protobuf.js
+const namedDescriptorSet = "Ck4KB3AucHJvdG8SEm9pcFByb3RvLnRlbXBsYXRlcyInCgFQEgwKBG5hbWUYASABKAkSFAoMZmxvQmlwNDRYUHViGAIgASgJYgZwcm90bzM="
+
+func namedTemplate(t *testing.T) *RecordTemplate {
+	t.Helper()
+	b, err := base64.StdEncoding.DecodeString(namedDescriptorSet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rt := &RecordTemplate{Txid: "deadbeef00000000000000000000000000000000000000000000000000000000", Identifier: 0xdeadbeef}
+	_, err = buildTemplate(rt, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rt
+}
+
+func TestProtoSource(t *testing.T) {
+	src, err := namedTemplate(t).ProtoSource()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"package oipProto.templates;", "message tmpl_DEADBEEF {", "string name = 1;", "string floBip44XPub = 2;"} {
+		if !strings.Contains(src, want) {
+			t.Errorf("proto source missing %q:\n%s", want, src)
+		}
+	}
+}
+
+func TestJSONSchema(t *testing.T) {
+	extended := namedTemplate(t)
+	templateCache[extended.Identifier] = extended
+	defer delete(templateCache, extended.Identifier)
+
+	rt := &RecordTemplate{
+		FriendlyName: "Sample",
+		Identifier:   1,
+		Extends:      []uint32{extended.Identifier, 2},
+		MessageDescriptor: message(t, &descriptor.DescriptorProto{
+			Field: []*descriptor.FieldDescriptorProto{
+				scalar("text", 1, descriptor.FieldDescriptorProto_TYPE_STRING),
+				scalar("count", 2, descriptor.FieldDescriptorProto_TYPE_INT64),
+				enumField("option", 3),
+				{
+					Name:   proto.String("tags"),
+					Number: proto.Int32(4),
+					Label:  descriptor.FieldDescriptorProto_LABEL_REPEATED.Enum(),
+					Type:   descriptor.FieldDescriptorProto_TYPE_STRING.Enum(),
+				},
+			},
+			EnumType: []*descriptor.EnumDescriptorProto{options("NONE", "ONE")},
+		}),
+	}
+
+	schema := rt.JSONSchema()
+	details := schema["properties"].(map[string]interface{})["details"].(map[string]interface{})
+	if required := details["required"]; !reflect.DeepEqual(required, []string{"tmpl_00000001", "tmpl_DEADBEEF", "tmpl_00000002"}) {
+		t.Errorf("required details = %v", required)
+	}
+	props := details["properties"].(map[string]interface{})
+	if ref := props["tmpl_00000001"]; !reflect.DeepEqual(ref, map[string]interface{}{"$ref": "#/definitions/oipProto.templates.P"}) {
+		t.Errorf("template reference = %v", ref)
+	}
+	if _, ok := props["tmpl_00000002"].(map[string]interface{})["$ref"]; ok {
+		t.Error("missing template has a definition")
+	}
+
+	definitions := schema["definitions"].(map[string]interface{})
+	if _, ok := definitions["oipProto.templates.tmpl_DEADBEEF"]; !ok {
+		t.Error("extended template not defined")
+	}
+	fields := definitions["oipProto.templates.P"].(map[string]interface{})["properties"].(map[string]interface{})
+	want := map[string]interface{}{
+		"text":   map[string]interface{}{"type": "string"},
+		"count":  map[string]interface{}{"type": "string", "pattern": "^-?[0-9]+$"},
+		"option": map[string]interface{}{"type": "string", "enum": []string{"NONE", "ONE"}},
+		"tags":   map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "string"}},
+	}
+	if !reflect.DeepEqual(fields, want) {
+		t.Errorf("fields = %v, want %v", fields, want)
+	}
+}
+
+func TestJSONSchemaWellKnown(t *testing.T) {
+	cases := []struct {
+		msg  proto.Message
+		want map[string]interface{}
+	}{
+		{&timestamp.Timestamp{}, map[string]interface{}{"type": "string", "format": "date-time"}},
+		{&duration.Duration{}, map[string]interface{}{"type": "string", "pattern": "^-?[0-9]+(\\.[0-9]+)?s$"}},
+		{&wrappers.BoolValue{}, map[string]interface{}{"type": "boolean"}},
+		{&wrappers.Int64Value{}, map[string]interface{}{"type": "string", "pattern": "^-?[0-9]+$"}},
+		{&wrappers.DoubleValue{}, map[string]interface{}{"type": "number"}},
+		{&structpb.Struct{}, map[string]interface{}{"type": "object"}},
+		{&structpb.Value{}, map[string]interface{}{}},
+		{&structpb.ListValue{}, map[string]interface{}{"type": "array"}},
+		{&empty.Empty{}, map[string]interface{}{"type": "object"}},
+		{&any.Any{}, map[string]interface{}{
+			"type":       "object",
+			"properties": map[string]interface{}{"@type": map[string]interface{}{"type": "string"}},
+			"required":   []string{"@type"},
+		}},
+	}
+	for _, c := range cases {
+		md, err := desc.LoadMessageDescriptorForMessage(c.msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s := &schemaBuilder{definitions: make(map[string]interface{})}
+		if got := s.ref(md); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s = %v, want %v", md.GetFullyQualifiedName(), got, c.want)
+		}
+		if len(s.definitions) != 0 {
+			t.Errorf("%s added definitions %v", md.GetFullyQualifiedName(), s.definitions)
+		}
+	}
+}
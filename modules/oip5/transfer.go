@@ -0,0 +1,237 @@
+package oip5
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+
+	"github.com/azer/logger"
+	"github.com/bitspill/flod/chaincfg"
+	"github.com/bitspill/floutil"
+	"github.com/oipwg/proto/go/pb_oip5"
+	"github.com/spf13/viper"
+	"gopkg.in/olivere/elastic.v6"
+
+	"github.com/oipwg/oip/config"
+	"github.com/oipwg/oip/datastore"
+	"github.com/oipwg/oip/flo"
+	"github.com/oipwg/oip/httpapi"
+	"github.com/oipwg/oip/modules/module"
+)
+
+const transferIndex = "oip5_transfer"
+
+// transferTemplate is the published template, configured by txid, which,
+// attached as the only detail of a record signed by the current owner, hands
+// the record it references over to new_owner:
+//
+//	message P { oipProto.Txid reference = 1; string new_owner = 2; string countersignature = 3; }
+//
+// The optional countersignature is new_owner's signature of "<reference>-<new_owner>"
+var transferTemplate = &actionTemplate{action: "transfer", key: "transferTemplate", fields: []string{"new_owner", "countersignature"}}
+
+var transferCommitMutex sync.Mutex
+
+func initTransfer(hooks *module.Hooks, cfg *viper.Viper) {
+	transferTemplate.configure(cfg)
+	hooks.Subscribe("datastore:commit", onDatastoreCommitTransfers)
+	httpapi.RegisterTraceReference(transferIndex, "transfer.reference")
+}
+
+type elasticOip5Transfer struct {
+	Transfer struct {
+		Reference        string `json:"reference"`
+		NewOwner         string `json:"new_owner"`
+		Countersignature string `json:"countersignature"`
+	} `json:"transfer"`
+	Meta DMeta `json:"meta"`
+}
+
+// OwnerChange records an owner of a record and the transaction making it so
+type OwnerChange struct {
+	Owner string `json:"owner"`
+	Txid  string `json:"txid"`
+	Time  int64  `json:"time"`
+}
+
+// recordOwner returns the address currently in control of rec, records
+// indexed before transfers existed are owned by their signer
+func recordOwner(rec *oip5Record) string {
+	if rec.Meta.Owner != "" {
+		return rec.Meta.Owner
+	}
+	return rec.Meta.SignedBy
+}
+
+func intakeTransfer(r *pb_oip5.RecordProto, pubKey []byte, tx *datastore.TransactionData) (*elastic.BulkIndexRequest, error) {
+	dm, ref, err := transferTemplate.decode(r)
+	if err != nil {
+		return nil, err
+	}
+	newOwner := dm.GetFieldByName("new_owner").(string)
+	if newOwner == "" {
+		return nil, errors.New("missing new owner")
+	}
+	if newOwner == string(pubKey) {
+		return nil, errors.New("transfer to self")
+	}
+	err = checkOwnerAddress(newOwner)
+	if err != nil {
+		return nil, err
+	}
+	countersig := dm.GetFieldByName("countersignature").(string)
+	if countersig != "" {
+		ok, err := flo.CheckSignature(newOwner, countersig, ref+"-"+newOwner)
+		if !ok {
+			return nil, err
+		}
+	}
+
+	var el elasticOip5Transfer
+	el.Transfer.Reference = ref
+	el.Transfer.NewOwner = newOwner
+	el.Transfer.Countersignature = countersig
+	el.Meta = DMeta{
+		Block:     tx.Block,
+		BlockHash: tx.BlockHash,
+		Applied:   false,
+		Invalid:   false,
+		SignedBy:  string(pubKey),
+		Time:      tx.Transaction.Time,
+		Tx:        tx,
+		Txid:      tx.Transaction.Txid,
+	}
+
+	bir := elastic.NewBulkIndexRequest().
+		Index(datastore.Index(transferIndex)).
+		Type("_doc").
+		Id(tx.Transaction.Txid).
+		Doc(el)
+
+	return bir, nil
+}
+
+// checkOwnerAddress verifies address is a flo address of the active network,
+// DecodeAddress alone accepts base58 addresses of any network
+func checkOwnerAddress(address string) error {
+	params := &chaincfg.MainNetParams
+	if config.IsTestnet() {
+		params = &chaincfg.TestNet3Params
+	}
+	addr, err := floutil.DecodeAddress(address, params)
+	if err != nil {
+		return err
+	}
+	if !addr.IsForNet(params) {
+		return errors.New("new owner not of the active network")
+	}
+	return nil
+}
+
+// onDatastoreCommitTransfers applies pending transfers in the order they were
+// published, those whose record is not yet known remain pending until it is
+func onDatastoreCommitTransfers() {
+	transferCommitMutex.Lock()
+	defer transferCommitMutex.Unlock()
+
+	q := elastic.NewBoolQuery().Must(
+		elastic.NewTermQuery("meta.applied", false),
+		elastic.NewTermQuery("meta.invalid", false),
+	)
+	res, err := datastore.Client().
+		Search(datastore.Index(transferIndex)).
+		Type("_doc").
+		Query(q).
+		Size(10000).
+		Sort("meta.time", true).
+		Sort("meta.txid", true).
+		Do(context.TODO())
+	if err != nil {
+		log.Error("elastic search failed", logger.Attrs{"err": err})
+		return
+	}
+
+	for _, v := range res.Hits.Hits {
+		var tr elasticOip5Transfer
+		err := json.Unmarshal(*v.Source, &tr)
+		if err != nil {
+			log.Info("failed to unmarshal elastic hit", logger.Attrs{"err": err})
+			continue
+		}
+		attr := logger.Attrs{"reference": tr.Transfer.Reference, "txid": tr.Meta.Txid}
+
+		rec, err := GetRecord(tr.Transfer.Reference)
+		if err != nil {
+			log.Info("transfer target not yet available", attr)
+			continue
+		}
+
+		if recordOwner(rec) != tr.Meta.SignedBy {
+			log.Error("transfer not signed by record owner", attr)
+			bur := elastic.NewBulkUpdateRequest().
+				Index(datastore.Index(transferIndex)).
+				Type("_doc").
+				Id(tr.Meta.Txid).
+				Doc(MetaInvalid{Invalid{true}})
+			datastore.AutoBulk.Add(bur)
+			continue
+		}
+
+		err = transferRecord(rec, tr)
+		if err != nil {
+			attr["err"] = err
+			log.Error("unable to transfer record", attr)
+			continue
+		}
+
+		bur := elastic.NewBulkUpdateRequest().
+			Index(datastore.Index(transferIndex)).
+			Type("_doc").
+			Id(tr.Meta.Txid).
+			Doc(MetaApplied{Applied{true}})
+		datastore.AutoBulk.Add(bur)
+	}
+}
+
+// transferRecord sets the new owner on every revision of rec
+func transferRecord(rec *oip5Record, tr elasticOip5Transfer) error {
+	history := rec.Meta.OwnerHistory
+	if len(history) == 0 {
+		history = []OwnerChange{{Owner: rec.Meta.SignedBy, Txid: rec.Meta.Original, Time: rec.Meta.Time}}
+	}
+	history = append(append([]OwnerChange(nil), history...), OwnerChange{
+		Owner: tr.Transfer.NewOwner,
+		Txid:  tr.Meta.Txid,
+		Time:  tr.Meta.Time,
+	})
+
+	pubName, err := GetPublisherName(tr.Transfer.NewOwner)
+	if err != nil {
+		pubName = ""
+	}
+
+	s := elastic.NewScript("ctx._source.meta.owner=params.owner;ctx._source.meta.owner_history=params.history;ctx._source.meta.publisher_name=params.pubName;").
+		Param("owner", tr.Transfer.NewOwner).
+		Param("history", history).
+		Param("pubName", pubName).
+		Type("inline").
+		Lang("painless")
+
+	res, err := datastore.Client().
+		UpdateByQuery(datastore.Index(o5RecordIndexName)).
+		Type("_doc").
+		Query(elastic.NewTermQuery("meta.original", rec.Meta.Original)).
+		Script(s).
+		Refresh("true").
+		Do(context.TODO())
+	if err != nil {
+		return err
+	}
+	log.Info("transferred", logger.Attrs{"original": rec.Meta.Original, "owner": tr.Transfer.NewOwner, "updated": res.Updated})
+
+	rec.Meta.Owner = tr.Transfer.NewOwner
+	rec.Meta.OwnerHistory = history
+	rec.Meta.PublisherName = pubName
+	return nil
+}
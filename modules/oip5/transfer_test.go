@@ -0,0 +1,162 @@
+package oip5
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/bitspill/flod/chaincfg"
+	"github.com/bitspill/flod/floec"
+	"github.com/bitspill/flod/flojson"
+	"github.com/bitspill/flosig"
+	"github.com/bitspill/floutil"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/oipwg/proto/go/pb_oip"
+	"github.com/oipwg/proto/go/pb_oip5"
+
+	"github.com/oipwg/oip/datastore"
+	"github.com/oipwg/oip/datastore/datastoretest"
+	"github.com/oipwg/oip/trace"
+)
+
+const transferTemplateTxid = "7ba45fe800000000000000000000000000000000000000000000000000000000"
+
+// useTransferTemplate publishes a transfer template and configures it
+func useTransferTemplate(t *testing.T) {
+	t.Helper()
+	reference := field("reference", 1, descriptor.FieldDescriptorProto_LABEL_OPTIONAL, descriptor.FieldDescriptorProto_TYPE_MESSAGE)
+	reference.TypeName = proto.String(".oipProto.templates.P.Txid")
+	registerTemplate(t, transferTemplateTxid, 0x7ba45fe8,
+		reference,
+		field("new_owner", 2, descriptor.FieldDescriptorProto_LABEL_OPTIONAL, descriptor.FieldDescriptorProto_TYPE_STRING),
+		field("countersignature", 3, descriptor.FieldDescriptorProto_LABEL_OPTIONAL, descriptor.FieldDescriptorProto_TYPE_STRING))
+	configureAction(transferTemplate, transferTemplateTxid)
+}
+
+func transfer(t *testing.T, reference, newOwner, countersig string) *pb_oip5.RecordProto {
+	t.Helper()
+	d := detail(t, transferTemplateTxid, map[string]interface{}{
+		"reference":        pb_oip.TxidFromString(reference),
+		"new_owner":        newOwner,
+		"countersignature": countersig,
+	})
+	return &pb_oip5.RecordProto{Details: &pb_oip5.OipDetails{Details: []*any.Any{d}}}
+}
+
+func TestIntakeTransfer(t *testing.T) {
+	const target = "e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6e6"
+	tx := &datastore.TransactionData{Transaction: &flojson.TxRawResult{Txid: "txid"}, Trace: trace.New("txid")}
+
+	pk, err := floec.NewPrivateKey(floec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, err := floutil.NewAddressPubKeyHash(floutil.Hash160(pk.PubKey().SerializeCompressed()), &chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	receiver := addr.EncodeAddress()
+	testnetAddr, err := floutil.NewAddressPubKeyHash(floutil.Hash160(pk.PubKey().SerializeCompressed()), &chaincfg.TestNet3Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testnetReceiver := testnetAddr.EncodeAddress()
+	countersig, err := flosig.SignMessagePk(target+"-"+receiver, "Florincoin", pk, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wrongsig, err := flosig.SignMessagePk(target+"-FOther", "Florincoin", pk, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	useTransferTemplate(t)
+	defer configureAction(transferTemplate, "")
+
+	cases := []struct {
+		name     string
+		newOwner string
+		sig      string
+		valid    bool
+	}{
+		{"unsigned", receiver, "", true},
+		{"countersigned", receiver, countersig, true},
+		{"bad countersignature", receiver, wrongsig, false},
+		{"missing new owner", "", "", false},
+		{"to self", "FOwner", "", false},
+		{"invalid new owner", "FOther", "", false},
+		{"testnet new owner", testnetReceiver, "", false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r := transfer(t, target, c.newOwner, c.sig)
+			if !transferTemplate.of(r) {
+				t.Fatal("transfer not recognized")
+			}
+			_, err := intakeTransfer(r, []byte("FOwner"), tx)
+			if (err == nil) != c.valid {
+				t.Errorf("intakeTransfer() = %v, want valid %v", err, c.valid)
+			}
+		})
+	}
+}
+
+func TestOnDatastoreCommitTransfers(t *testing.T) {
+	const original = "f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3f3"
+
+	s := datastoretest.NewServer()
+	defer s.Close()
+	datastore.SetClient(s.Client())
+
+	s.Put(datastore.Index(o5RecordIndexName), original, map[string]interface{}{
+		"meta": map[string]interface{}{"txid": original, "original": original, "latest": false, "signed_by": "FAlice", "time": 50},
+	})
+	s.Put(datastore.Index(o5RecordIndexName), "f3edit", map[string]interface{}{
+		"meta": map[string]interface{}{"txid": "f3edit", "original": original, "latest": true, "signed_by": "FAlice", "time": 50},
+	})
+	putTransfer := func(txid, signer, newOwner string, time int64) {
+		var el elasticOip5Transfer
+		el.Transfer.Reference = original
+		el.Transfer.NewOwner = newOwner
+		el.Meta = DMeta{Txid: txid, SignedBy: signer, Time: time}
+		s.Put(datastore.Index(transferIndex), txid, el)
+	}
+	putTransfer("t1", "FAlice", "FBob", 100)
+	putTransfer("t2", "FAlice", "FCarol", 200)
+	putTransfer("t3", "FBob", "FCarol", 300)
+
+	onDatastoreCommitTransfers()
+	datastore.AutoBulk.Commit()
+
+	for txid, want := range map[string]DMeta{"t1": {Applied: true}, "t2": {Invalid: true}, "t3": {Applied: true}} {
+		b, _ := s.Get(datastore.Index(transferIndex), txid)
+		var el elasticOip5Transfer
+		if err := json.Unmarshal(b, &el); err != nil {
+			t.Fatal(err)
+		}
+		if el.Meta.Applied != want.Applied || el.Meta.Invalid != want.Invalid {
+			t.Errorf("%s applied %v invalid %v", txid, el.Meta.Applied, el.Meta.Invalid)
+		}
+	}
+
+	wantHistory := []OwnerChange{{"FAlice", original, 50}, {"FBob", "t1", 100}, {"FCarol", "t3", 300}}
+	for _, txid := range []string{original, "f3edit"} {
+		b, _ := s.Get(datastore.Index(o5RecordIndexName), txid)
+		var el elasticOip5Record
+		if err := json.Unmarshal(b, &el); err != nil {
+			t.Fatal(err)
+		}
+		if el.Meta.Owner != "FCarol" || !reflect.DeepEqual(el.Meta.OwnerHistory, wantHistory) {
+			t.Errorf("%s owner %s history %v", txid, el.Meta.Owner, el.Meta.OwnerHistory)
+		}
+	}
+
+	rec, err := GetRecord(original)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recordOwner(rec) != "FCarol" {
+		t.Errorf("record owner %s", recordOwner(rec))
+	}
+}
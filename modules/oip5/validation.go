@@ -0,0 +1,228 @@
+package oip5
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/azer/logger"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/oipwg/proto/go/pb_oip5"
+	"github.com/spf13/viper"
+	"gopkg.in/olivere/elastic.v6"
+
+	"github.com/oipwg/oip/datastore"
+	"github.com/oipwg/oip/modules/module"
+	"github.com/oipwg/oip/modules/oip5/templates"
+)
+
+// strictness of record validation, set by oip.modules.oip5.recordValidation
+const (
+	// validationAccept indexes records without validating them
+	validationAccept = "accept"
+	// validationFlag indexes every record, flagging the invalid ones
+	validationFlag = "flag"
+	// validationReject refuses invalid records, those found invalid once a
+	// missing template arrives are deactivated
+	validationReject = "reject"
+)
+
+const (
+	validationValid   = "valid"
+	validationInvalid = "invalid"
+	validationPending = "pending"
+)
+
+var recordValidation = validationFlag
+var revalidateMutex sync.Mutex
+
+// templatesAdded is set when a template is accepted, pending records are
+// revalidated on the following commit
+var templatesAdded int32
+
+type Validation struct {
+	Status   string   `json:"status"`
+	Problems []string `json:"problems,omitempty"`
+	Pending  []string `json:"pending,omitempty"`
+}
+
+func initValidation(hooks *module.Hooks, cfg *viper.Viper) {
+	switch rv := cfg.GetString("recordValidation"); rv {
+	case validationAccept, validationFlag, validationReject:
+		recordValidation = rv
+	case "":
+	default:
+		log.Error("unknown record validation, flagging invalid records", logger.Attrs{"recordValidation": rv})
+		recordValidation = validationFlag
+	}
+	if recordValidation == validationAccept {
+		return
+	}
+	hooks.Subscribe("datastore:commit", onDatastoreCommitRevalidate)
+	atomic.StoreInt32(&templatesAdded, 1)
+}
+
+// validateRecord checks that every detail of r decodes as its template and
+// that the templates extended by those are present; nil when validation is off
+func validateRecord(r *pb_oip5.RecordProto) *Validation {
+	if recordValidation == validationAccept {
+		return nil
+	}
+
+	v := &Validation{}
+	if r.Details == nil || len(r.Details.Details) == 0 {
+		v.Problems = append(v.Problems, "record has no details")
+	}
+
+	present := make(map[string]bool)
+	var known []*templates.RecordTemplate
+	for i, d := range r.Details.GetDetails() {
+		typeName := d.TypeUrl[strings.LastIndex(d.TypeUrl, "/")+1:]
+		name := typeName[strings.LastIndex(typeName, ".")+1:]
+		present[strings.ToUpper(name)] = true
+
+		m, err := templates.CreateNewMessage(typeName)
+		if err != nil {
+			if isTemplateName(name) {
+				v.Pending = append(v.Pending, name)
+			} else {
+				v.Problems = append(v.Problems, fmt.Sprintf("detail %d has unknown type %q", i, typeName))
+			}
+			continue
+		}
+		err = proto.Unmarshal(d.Value, m)
+		if err != nil {
+			v.Problems = append(v.Problems, fmt.Sprintf("detail %s does not decode: %s", name, err))
+			continue
+		}
+		if tmpl, err := templates.GetTemplate(strings.TrimPrefix(name, "tmpl_")); err == nil && tmpl != nil {
+			known = append(known, tmpl)
+		}
+	}
+
+	for _, tmpl := range known {
+		for _, ext := range tmpl.Extends {
+			if !present[strings.ToUpper(templateName(ext))] {
+				v.Problems = append(v.Problems, fmt.Sprintf("%s extends missing %s", tmpl.Name, templateName(ext)))
+			}
+		}
+	}
+
+	switch {
+	case len(v.Problems) != 0:
+		v.Status = validationInvalid
+	case len(v.Pending) != 0:
+		v.Status = validationPending
+	default:
+		v.Status = validationValid
+	}
+	return v
+}
+
+func isTemplateName(name string) bool {
+	if len(name) != 13 || !strings.HasPrefix(name, "tmpl_") {
+		return false
+	}
+	_, err := strconv.ParseUint(name[5:], 16, 32)
+	return err == nil
+}
+
+// onDatastoreCommitRevalidate revalidates pending records once new templates
+// have been indexed
+func onDatastoreCommitRevalidate() {
+	if !atomic.CompareAndSwapInt32(&templatesAdded, 1, 0) {
+		return
+	}
+
+	revalidateMutex.Lock()
+	defer revalidateMutex.Unlock()
+
+	searchSize := 1000
+	var after []interface{}
+	for {
+		search := datastore.Client().
+			Search(datastore.Index(o5RecordIndexName)).
+			Type("_doc").
+			Query(elastic.NewTermQuery("meta.validation.status", validationPending)).
+			Size(searchSize).
+			Sort("meta.txid", true)
+		if after != nil {
+			search.SearchAfter(after...)
+		}
+		res, err := search.Do(context.TODO())
+		if err != nil {
+			log.Error("elastic search failed", logger.Attrs{"err": err})
+			return
+		}
+
+		for _, h := range res.Hits.Hits {
+			var eRec elasticOip5Record
+			err := json.Unmarshal(*h.Source, &eRec)
+			if err != nil {
+				log.Info("failed to unmarshal elastic hit", logger.Attrs{"err": err})
+				continue
+			}
+			rec, err := decodeElasticRecord(eRec)
+			if err != nil {
+				log.Error("unable to decode record", logger.Attrs{"err": err, "txid": eRec.Meta.Txid})
+				continue
+			}
+			revalidateRecord(h.Id, rec)
+		}
+
+		if len(res.Hits.Hits) < searchSize {
+			return
+		}
+		after = res.Hits.Hits[len(res.Hits.Hits)-1].Sort
+	}
+}
+
+// revalidateRecord reindexes the record stored as id if its status is settled
+func revalidateRecord(id string, rec *oip5Record) {
+	v := validateRecord(rec.Record)
+	if v == nil || v.Status == validationPending {
+		return
+	}
+	attr := logger.Attrs{"txid": rec.Meta.Txid, "status": v.Status, "problems": v.Problems}
+	log.Info("revalidated record", attr)
+
+	rec.Meta.Validation = v
+	if v.Status == validationInvalid && recordValidation == validationReject {
+		rec.Meta.Deactivated = true
+	}
+
+	var el elasticOip5Record
+	var m jsonpb.Marshaler
+	var buf bytes.Buffer
+	err := m.Marshal(&buf, rec.Record)
+	if err == nil {
+		el.Record = buf.Bytes()
+	} else if v.Status == validationValid {
+		attr["err"] = err
+		log.Error("unable to marshal revalidated record", attr)
+		return
+	}
+	el.Meta = rec.Meta
+
+	bir := elastic.NewBulkIndexRequest().
+		Index(datastore.Index(o5RecordIndexName)).
+		Type("_doc").
+		Id(id).
+		Doc(el)
+	datastore.AutoBulk.Add(bir)
+
+	if r, found := recordCache.Get(rec.Meta.Original); found {
+		r.(*oip5Record).Meta.Validation = v
+	}
+
+	if v.Status == validationValid {
+		normalizeRecord(rec, nil)
+	}
+	indexReferences(rec, nil, nil)
+}
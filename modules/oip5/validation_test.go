@@ -0,0 +1,111 @@
+package oip5
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/oipwg/proto/go/pb_oip5"
+
+	"github.com/oipwg/oip/datastore"
+	"github.com/oipwg/oip/datastore/datastoretest"
+	"github.com/oipwg/oip/modules/oip5/templates"
+)
+
+func TestValidateRecord(t *testing.T) {
+	registerTestTemplates(t)
+
+	album, err := templates.GetTemplate(albumTemplateTxid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func(extends []uint32) { album.Extends = extends }(album.Extends)
+
+	artistDetail := detail(t, artistTemplateTxid, map[string]interface{}{"name": "Ryan"})
+	albumDetail := detail(t, albumTemplateTxid, map[string]interface{}{"title": "Songs"})
+
+	cases := []struct {
+		name    string
+		extends []uint32
+		details []*any.Any
+		status  string
+		pending []string
+	}{
+		{"valid", nil, []*any.Any{albumDetail}, validationValid, nil},
+		{"no details", nil, nil, validationInvalid, nil},
+		{"unknown template", nil, []*any.Any{albumDetail, {TypeUrl: "type.googleapis.com/oipProto.templates.tmpl_0BADBEEF"}}, validationPending, []string{"tmpl_0BADBEEF"}},
+		{"unknown type", nil, []*any.Any{{TypeUrl: "type.googleapis.com/oipProto.Unknown"}}, validationInvalid, nil},
+		{"undecodable", nil, []*any.Any{{TypeUrl: albumDetail.TypeUrl, Value: []byte{0xff}}}, validationInvalid, nil},
+		{"extends present", []uint32{artistTemplate}, []*any.Any{albumDetail, artistDetail}, validationValid, nil},
+		{"extends missing", []uint32{artistTemplate}, []*any.Any{albumDetail}, validationInvalid, nil},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			album.Extends = c.extends
+			v := validateRecord(&pb_oip5.RecordProto{Details: &pb_oip5.OipDetails{Details: c.details}})
+			if v.Status != c.status || !reflect.DeepEqual(v.Pending, c.pending) {
+				t.Errorf("validateRecord() = %+v, want status %s pending %v", v, c.status, c.pending)
+			}
+		})
+	}
+}
+
+func TestOnDatastoreCommitRevalidate(t *testing.T) {
+	const lateTemplateTxid = "c0ffee0300000000000000000000000000000000000000000000000000000000"
+	const lateTemplate = 0xc0ffee03
+	const txid = "c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3"
+
+	s := datastoretest.NewServer()
+	defer s.Close()
+	datastore.SetClient(s.Client())
+
+	// the payload of the template registered below, {"note": "hello"}
+	r := &pb_oip5.RecordProto{Details: &pb_oip5.OipDetails{Details: []*any.Any{{
+		TypeUrl: "type.googleapis.com/oipProto.templates." + templateName(lateTemplate),
+		Value:   []byte{0x0a, 0x05, 'h', 'e', 'l', 'l', 'o'},
+	}}}}
+	v := validateRecord(r)
+	if v.Status != validationPending {
+		t.Fatalf("record using an unknown template %+v", v)
+	}
+	raw, err := proto.Marshal(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Put(datastore.Index(o5RecordIndexName), txid, elasticOip5Record{Meta: RMeta{
+		Txid:       txid,
+		Original:   txid,
+		Latest:     true,
+		RecordRaw:  base64.StdEncoding.EncodeToString(raw),
+		Validation: v,
+	}})
+
+	status := func() string {
+		b, _ := s.Get(datastore.Index(o5RecordIndexName), txid)
+		var el elasticOip5Record
+		if err := json.Unmarshal(b, &el); err != nil {
+			t.Fatal(err)
+		}
+		return el.Meta.Validation.Status
+	}
+
+	templatesAdded = 1
+	onDatastoreCommitRevalidate()
+	datastore.AutoBulk.Commit()
+	if st := status(); st != validationPending {
+		t.Errorf("status %s before the template arrived", st)
+	}
+
+	registerTemplate(t, lateTemplateTxid, lateTemplate,
+		field("note", 1, descriptor.FieldDescriptorProto_LABEL_OPTIONAL, descriptor.FieldDescriptorProto_TYPE_STRING))
+	templatesAdded = 1
+	onDatastoreCommitRevalidate()
+	datastore.AutoBulk.Commit()
+	if st := status(); st != validationValid {
+		t.Errorf("status %s once the template arrived", st)
+	}
+}
@@ -0,0 +1,87 @@
+package script
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
+	"gopkg.in/olivere/elastic.v6"
+
+	"github.com/oipwg/oip/httpapi"
+)
+
+var scriptSorts = []elastic.SortInfo{
+	{Field: "meta.time", Ascending: false},
+	{Field: "meta.txid", Ascending: true},
+}
+
+// registerApi mounts the read routes of a script index
+func registerApi(index string) {
+	indices := []string{indexPrefix + index}
+	router := httpapi.NewSubRoute("/script/" + index)
+	router.HandleFunc("/get/latest", handleLatest(indices), httpapi.RouteDoc{
+		Summary:  "Latest " + index + " documents emitted by a script",
+		Paged:    true,
+		Response: httpapi.SearchResponse(nil),
+	})
+	router.HandleFunc("/get/{id:[a-f0-9]+}", handleGet(indices), httpapi.RouteDoc{
+		Summary:  index + " documents by txid or txid prefix",
+		Response: httpapi.SearchResponse(nil),
+	})
+	router.HandleFunc("/search", handleSearch(indices), httpapi.RouteDoc{
+		Summary:  "Search " + index + " documents with an Elasticsearch query string",
+		Paged:    true,
+		Response: httpapi.SearchResponse(nil),
+	}).Queries("q", "{query}")
+}
+
+func handleLatest(indices []string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		searchService := httpapi.BuildCommonSearchService(
+			r.Context(),
+			indices,
+			elastic.NewMatchAllQuery(),
+			scriptSorts,
+			nil,
+		)
+		httpapi.RespondSearch(r.Context(), w, searchService)
+	}
+}
+
+func handleGet(indices []string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var opts = mux.Vars(r)
+
+		searchService := httpapi.BuildCommonSearchService(
+			r.Context(),
+			indices,
+			elastic.NewPrefixQuery("meta.txid", opts["id"]),
+			scriptSorts,
+			nil,
+		)
+		httpapi.RespondSearch(r.Context(), w, searchService)
+	}
+}
+
+func handleSearch(indices []string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var opts = mux.Vars(r)
+
+		searchQuery, err := url.PathUnescape(opts["query"])
+		if err != nil {
+			httpapi.RespondJSON(r.Context(), w, 400, map[string]interface{}{
+				"error": "unable to decode query",
+			})
+			return
+		}
+
+		searchService := httpapi.BuildCommonSearchService(
+			r.Context(),
+			indices,
+			elastic.NewQueryStringQuery(searchQuery).AnalyzeWildcard(false),
+			scriptSorts,
+			nil,
+		)
+		httpapi.RespondSearch(r.Context(), w, searchService)
+	}
+}
@@ -0,0 +1,336 @@
+package script
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/azer/logger"
+	"go.starlark.net/lib/json"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+	"gopkg.in/olivere/elastic.v6"
+
+	"github.com/oipwg/oip/datastore"
+	"github.com/oipwg/oip/dispatch"
+	"github.com/oipwg/oip/metrics"
+	"github.com/oipwg/oip/rejections"
+)
+
+// thread locals
+const (
+	scriptKey  = "script"
+	txKey      = "tx"
+	emittedKey = "emitted"
+)
+
+// loadBuiltins are predeclared in every script
+// index, route and api may only be called while loading, emit and reject only from a route handler
+var loadBuiltins = starlark.StringDict{
+	"index":  starlark.NewBuiltin("index", builtinIndex),
+	"route":  starlark.NewBuiltin("route", builtinRoute),
+	"api":    starlark.NewBuiltin("api", builtinApi),
+	"emit":   starlark.NewBuiltin("emit", builtinEmit),
+	"reject": starlark.NewBuiltin("reject", builtinReject),
+	"json":   json.Module,
+}
+
+func loading(thread *starlark.Thread, b *starlark.Builtin) (*script, error) {
+	sc := thread.Local(scriptKey).(*script)
+	if sc.globals != nil || thread.Local(txKey) != nil {
+		return nil, fmt.Errorf("%s: may only be called while the script is loading", b.Name())
+	}
+	return sc, nil
+}
+
+func handling(thread *starlark.Thread, b *starlark.Builtin) (*script, *datastore.TransactionData, error) {
+	tx, ok := thread.Local(txKey).(*datastore.TransactionData)
+	if !ok {
+		return nil, nil, fmt.Errorf("%s: may only be called from a route handler", b.Name())
+	}
+	return thread.Local(scriptKey).(*script), tx, nil
+}
+
+// index(name, properties) declares the index script_<name> with the elasticsearch mapping properties
+func builtinIndex(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	sc, err := loading(thread, b)
+	if err != nil {
+		return nil, err
+	}
+	var name string
+	var properties *starlark.Dict
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "name", &name, "properties", &properties); err != nil {
+		return nil, err
+	}
+	if !nameRe.MatchString(name) {
+		return nil, fmt.Errorf("%s: invalid name %q", b.Name(), name)
+	}
+	if _, ok := sc.indices[name]; ok {
+		return nil, fmt.Errorf("%s: %s declared twice", b.Name(), name)
+	}
+	p, err := toGo(properties)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", b.Name(), err)
+	}
+	sc.indices[name] = p.(map[string]interface{})
+	return starlark.None, nil
+}
+
+// route(name, handler, prefix=, strip_prefix=, compact_prefix=, regex=, json_key=,
+//
+//	priority=0, mainnet=True, testnet=True, coinbase=False, fallthrough=False)
+//
+// dispatches matching floData to handler(payload, tx), exactly one matcher must be provided
+// mainnet and testnet accept True, False or a (min, max) block height window, 0 being unbounded
+func builtinRoute(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	sc, err := loading(thread, b)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		name                                               string
+		fn                                                 starlark.Callable
+		prefix, stripPrefix, compactPrefix, regex, jsonKey string
+		priority                                           int
+		mainnet, testnet                                   starlark.Value = starlark.True, starlark.True
+		coinbase, fallThrough                              bool
+	)
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs,
+		"name", &name, "handler", &fn,
+		"prefix?", &prefix, "strip_prefix?", &stripPrefix, "compact_prefix?", &compactPrefix,
+		"regex?", &regex, "json_key?", &jsonKey,
+		"priority?", &priority, "mainnet?", &mainnet, "testnet?", &testnet,
+		"coinbase?", &coinbase, "fallthrough?", &fallThrough); err != nil {
+		return nil, err
+	}
+	if !nameRe.MatchString(name) {
+		return nil, fmt.Errorf("%s: invalid name %q", b.Name(), name)
+	}
+	for _, r := range sc.routes {
+		if r.route.Name == name {
+			return nil, fmt.Errorf("%s: %s declared twice", b.Name(), name)
+		}
+	}
+
+	var matchers []dispatch.Matcher
+	if prefix != "" {
+		matchers = append(matchers, dispatch.Prefix(prefix))
+	}
+	if stripPrefix != "" {
+		matchers = append(matchers, dispatch.StripPrefix(stripPrefix))
+	}
+	if compactPrefix != "" {
+		matchers = append(matchers, dispatch.CompactPrefix(compactPrefix))
+	}
+	if regex != "" {
+		re, err := regexp.Compile(regex)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", b.Name(), err)
+		}
+		matchers = append(matchers, dispatch.Regex(re))
+	}
+	if jsonKey != "" {
+		matchers = append(matchers, dispatch.JsonKey(jsonKey))
+	}
+	if len(matchers) != 1 {
+		return nil, fmt.Errorf("%s: exactly one of prefix, strip_prefix, compact_prefix, regex or json_key is required", b.Name())
+	}
+
+	r := dispatch.Route{
+		Name:        name,
+		Match:       matchers[0],
+		Priority:    priority,
+		Coinbase:    coinbase,
+		Fallthrough: fallThrough,
+	}
+	if r.Mainnet, err = toWindow(mainnet); err != nil {
+		return nil, fmt.Errorf("%s: mainnet: %v", b.Name(), err)
+	}
+	if r.Testnet, err = toWindow(testnet); err != nil {
+		return nil, fmt.Errorf("%s: testnet: %v", b.Name(), err)
+	}
+	sc.routes = append(sc.routes, scriptRoute{route: r, fn: fn})
+	return starlark.None, nil
+}
+
+func toWindow(v starlark.Value) (*dispatch.Window, error) {
+	switch v := v.(type) {
+	case starlark.NoneType:
+		return nil, nil
+	case starlark.Bool:
+		if !v {
+			return nil, nil
+		}
+		return &dispatch.Window{}, nil
+	case starlark.Indexable:
+		if v.Len() != 2 {
+			return nil, fmt.Errorf("window must be (min, max)")
+		}
+		var w dispatch.Window
+		if err := starlark.AsInt(v.Index(0), &w.Min); err != nil {
+			return nil, err
+		}
+		if err := starlark.AsInt(v.Index(1), &w.Max); err != nil {
+			return nil, err
+		}
+		return &w, nil
+	}
+	return nil, fmt.Errorf("got %s, want bool or (min, max)", v.Type())
+}
+
+// api(index) mounts read routes for a declared index under /oip/script/<index>
+func builtinApi(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	sc, err := loading(thread, b)
+	if err != nil {
+		return nil, err
+	}
+	var index string
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "index", &index); err != nil {
+		return nil, err
+	}
+	if _, ok := sc.indices[index]; !ok {
+		return nil, fmt.Errorf("%s: index %s not declared", b.Name(), index)
+	}
+	for _, a := range sc.apis {
+		if a == index {
+			return starlark.None, nil
+		}
+	}
+	sc.apis = append(sc.apis, index)
+	return starlark.None, nil
+}
+
+// emit(index, doc, id=tx.txid) indexes doc with the transaction meta into a declared index
+func builtinEmit(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	sc, tx, err := handling(thread, b)
+	if err != nil {
+		return nil, err
+	}
+	var index, id string
+	var doc *starlark.Dict
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "index", &index, "doc", &doc, "id?", &id); err != nil {
+		return nil, err
+	}
+	if _, ok := sc.indices[index]; !ok {
+		return nil, fmt.Errorf("%s: index %s not declared", b.Name(), index)
+	}
+	if id == "" {
+		id = tx.Transaction.Txid
+	}
+	d, err := toGo(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", b.Name(), err)
+	}
+	m := d.(map[string]interface{})
+	if _, ok := m["meta"]; ok {
+		return nil, fmt.Errorf("%s: meta is reserved", b.Name())
+	}
+	m["meta"] = map[string]interface{}{
+		"txid":       tx.Transaction.Txid,
+		"block":      tx.Block,
+		"block_hash": tx.BlockHash,
+		"time":       tx.Transaction.Time,
+	}
+
+	bir := elastic.NewBulkIndexRequest().
+		Index(datastore.Index(indexPrefix + index)).
+		Type("_doc").
+		Id(id).
+		Doc(m)
+	datastore.AutoBulk.AddFor(tx, bir)
+	*thread.Local(emittedKey).(*int)++
+	return starlark.None, nil
+}
+
+// reject(reason) records the message as rejected
+func builtinReject(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	sc, tx, err := handling(thread, b)
+	if err != nil {
+		return nil, err
+	}
+	var reason string
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "reason", &reason); err != nil {
+		return nil, err
+	}
+	rejections.Reject(tx, sc.module(), rejections.StageValidate, reason, nil)
+	return starlark.None, nil
+}
+
+// handler adapts a script route handler to an event handler
+func (sc *script) handler(fn starlark.Callable, maxSteps uint64) func(string, *datastore.TransactionData) {
+	return func(payload string, tx *datastore.TransactionData) {
+		thread := sc.thread(fn.Name(), maxSteps)
+		thread.SetLocal(txKey, tx)
+		emitted := 0
+		thread.SetLocal(emittedKey, &emitted)
+
+		_, err := starlark.Call(thread, fn, starlark.Tuple{starlark.String(payload), txValue(tx)}, nil)
+		if err != nil {
+			msg := err.Error()
+			if ee, ok := err.(*starlark.EvalError); ok {
+				msg = ee.Backtrace()
+			}
+			log.Error("script handler failed", logger.Attrs{"script": sc.name, "handler": fn.Name(),
+				"txid": tx.Transaction.Txid, "err": msg})
+			rejections.Reject(tx, sc.module(), rejections.StageValidate, "script handler failed", err)
+			return
+		}
+		if emitted > 0 {
+			metrics.Accepted(sc.module())
+		}
+	}
+}
+
+func txValue(tx *datastore.TransactionData) starlark.Value {
+	return starlarkstruct.FromStringDict(starlarkstruct.Default, starlark.StringDict{
+		"txid":       starlark.String(tx.Transaction.Txid),
+		"block":      starlark.MakeInt64(tx.Block),
+		"block_hash": starlark.String(tx.BlockHash),
+		"time":       starlark.MakeInt64(tx.Transaction.Time),
+		"confirmed":  starlark.Bool(tx.Confirmed),
+		"coinbase":   starlark.Bool(tx.IsCoinbase),
+	})
+}
+
+// toGo converts a starlark value into its json compatible go equivalent
+func toGo(v starlark.Value) (interface{}, error) {
+	switch v := v.(type) {
+	case starlark.NoneType:
+		return nil, nil
+	case starlark.Bool:
+		return bool(v), nil
+	case starlark.Int:
+		if i, ok := v.Int64(); ok {
+			return i, nil
+		}
+		return nil, fmt.Errorf("integer %s out of range", v)
+	case starlark.Float:
+		return float64(v), nil
+	case starlark.String:
+		return string(v), nil
+	case *starlark.Dict:
+		m := make(map[string]interface{}, v.Len())
+		for _, item := range v.Items() {
+			k, ok := starlark.AsString(item[0])
+			if !ok {
+				return nil, fmt.Errorf("dict keys must be strings, got %s", item[0].Type())
+			}
+			e, err := toGo(item[1])
+			if err != nil {
+				return nil, err
+			}
+			m[k] = e
+		}
+		return m, nil
+	case starlark.Indexable:
+		l := make([]interface{}, v.Len())
+		for i := range l {
+			e, err := toGo(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			l[i] = e
+		}
+		return l, nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", v.Type())
+}
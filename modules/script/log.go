@@ -0,0 +1,5 @@
+package script
+
+import "github.com/azer/logger"
+
+var log = logger.New("script")
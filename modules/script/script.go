@@ -0,0 +1,215 @@
+package script
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/azer/logger"
+	"github.com/spf13/viper"
+	"go.starlark.net/starlark"
+
+	"github.com/oipwg/oip/config"
+	"github.com/oipwg/oip/datastore"
+	"github.com/oipwg/oip/dispatch"
+	"github.com/oipwg/oip/modules/module"
+)
+
+// scripts index into script_<name> to avoid colliding with built in modules
+const indexPrefix = "script_"
+
+var nameRe = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// defaultMaxSteps bounds script calls when maxSteps is unset, a limit of 0
+// would let a script run forever
+const defaultMaxSteps = 1000000
+
+type scripts struct {
+	hooks    module.Hooks
+	maxSteps uint64
+	loaded   []*script
+}
+
+func init() {
+	module.Register(&scripts{})
+}
+
+func (s *scripts) Name() string {
+	return "script"
+}
+
+// EnabledByDefault as scripts are executed from the app directory
+func (s *scripts) EnabledByDefault() bool {
+	return false
+}
+
+// Prepare loads every *.star script of the scripts directory registering the indices they declare
+func (s *scripts) Prepare(cfg *viper.Viper) error {
+	s.maxSteps = defaultMaxSteps
+	if steps := cfg.GetInt64("maxSteps"); steps > 0 {
+		s.maxSteps = uint64(steps)
+	}
+
+	dir := config.GetFilePath("oip.modules.script.dir")
+	files, err := filepath.Glob(filepath.Join(dir, "*.star"))
+	if err != nil {
+		return err
+	}
+	sort.Strings(files)
+
+	indices := make(map[string]string)
+	for _, file := range files {
+		sc, err := s.load(file)
+		if err != nil {
+			return err
+		}
+		for index := range sc.indices {
+			if other, ok := indices[index]; ok {
+				return fmt.Errorf("index %s declared by both %s and %s", index, other, sc.name)
+			}
+			indices[index] = sc.name
+		}
+		s.loaded = append(s.loaded, sc)
+		log.Info("loaded script", logger.Attrs{"script": sc.name, "file": file,
+			"indices": len(sc.indices), "routes": len(sc.routes)})
+	}
+
+	for _, sc := range s.loaded {
+		for index, properties := range sc.indices {
+			mapping, err := indexMapping(properties)
+			if err != nil {
+				return fmt.Errorf("script %s index %s: %v", sc.name, index, err)
+			}
+			datastore.RegisterMappingJSON(indexPrefix+index, mapping)
+		}
+	}
+	return nil
+}
+
+// Mappings are declared by the scripts and registered during Prepare
+func (s *scripts) Mappings() map[string]string {
+	return nil
+}
+
+func (s *scripts) Routes() {
+	for _, sc := range s.loaded {
+		for _, index := range sc.apis {
+			registerApi(index)
+		}
+	}
+}
+
+func (s *scripts) Init(ctx context.Context, cfg *viper.Viper) error {
+	for _, sc := range s.loaded {
+		for _, r := range sc.routes {
+			route := r.route
+			route.Name = "script:" + sc.name + ":" + r.route.Name
+			route.Topic = "modules:script:" + sc.name + ":" + r.route.Name
+			s.hooks.Subscribe(route.Topic, sc.handler(r.fn, s.maxSteps))
+			s.hooks.Dispatch(route)
+		}
+	}
+	return nil
+}
+
+func (s *scripts) Shutdown(ctx context.Context) error {
+	s.hooks.Remove()
+	return nil
+}
+
+// script is a loaded starlark script, its globals are frozen once loaded
+type script struct {
+	name    string
+	globals starlark.StringDict
+	// declared index name to its mapping properties
+	indices map[string]map[string]interface{}
+	routes  []scriptRoute
+	// indices with read routes
+	apis []string
+}
+
+type scriptRoute struct {
+	route dispatch.Route
+	fn    starlark.Callable
+}
+
+func (s *scripts) load(file string) (*script, error) {
+	name := strings.TrimSuffix(filepath.Base(file), ".star")
+	if !nameRe.MatchString(name) {
+		return nil, fmt.Errorf("invalid script name %s, must match %s", name, nameRe)
+	}
+	src, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	sc := &script{
+		name:    name,
+		indices: make(map[string]map[string]interface{}),
+	}
+	thread := sc.thread("load", s.maxSteps)
+	sc.globals, err = starlark.ExecFile(thread, file, src, loadBuiltins)
+	if err != nil {
+		if ee, ok := err.(*starlark.EvalError); ok {
+			return nil, fmt.Errorf("script %s: %s", name, ee.Backtrace())
+		}
+		return nil, fmt.Errorf("script %s: %v", name, err)
+	}
+	return sc, nil
+}
+
+func (sc *script) thread(name string, maxSteps uint64) *starlark.Thread {
+	thread := &starlark.Thread{
+		Name: sc.name + ":" + name,
+		Print: func(thread *starlark.Thread, msg string) {
+			log.Info(msg, logger.Attrs{"script": sc.name})
+		},
+	}
+	if maxSteps > 0 {
+		thread.SetMaxExecutionSteps(maxSteps)
+	}
+	thread.SetLocal(scriptKey, sc)
+	return thread
+}
+
+func (sc *script) module() string {
+	return "script:" + sc.name
+}
+
+// indexMapping wraps the declared properties with the common settings and meta fields
+func indexMapping(properties map[string]interface{}) (string, error) {
+	if _, ok := properties["meta"]; ok {
+		return "", fmt.Errorf("meta is reserved")
+	}
+	props := make(map[string]interface{}, len(properties)+1)
+	for k, v := range properties {
+		props[k] = v
+	}
+	props["meta"] = map[string]interface{}{
+		"properties": map[string]interface{}{
+			"txid":       map[string]interface{}{"type": "keyword", "ignore_above": 64},
+			"block":      map[string]interface{}{"type": "long"},
+			"block_hash": map[string]interface{}{"type": "keyword", "ignore_above": 64},
+			"time":       map[string]interface{}{"type": "date", "format": "epoch_second"},
+		},
+	}
+	mapping := map[string]interface{}{
+		"settings": map[string]interface{}{
+			"number_of_shards":   1,
+			"number_of_replicas": 0,
+		},
+		"mappings": map[string]interface{}{
+			"_doc": map[string]interface{}{
+				"dynamic":    "strict",
+				"properties": props,
+			},
+		},
+	}
+	b, err := json.Marshal(mapping)
+	return string(b), err
+}
@@ -0,0 +1,133 @@
+package script
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bitspill/flod/flojson"
+	"github.com/spf13/viper"
+
+	"github.com/oipwg/oip/datastore"
+	"github.com/oipwg/oip/trace"
+)
+
+const inventoryScript = `
+index("inventory", {
+    "sku": {"type": "keyword"},
+    "qty": {"type": "long"},
+})
+api("inventory")
+
+def on_inventory(payload, tx):
+    d = json.decode(payload)
+    if "sku" not in d:
+        reject("missing sku")
+        return
+    emit("inventory", {"sku": d["sku"], "qty": d.get("qty", 0)})
+
+route("inventory", on_inventory, strip_prefix = "inv:", mainnet = (2000000, 0), testnet = False)
+`
+
+func TestScript(t *testing.T) {
+	dir, err := ioutil.TempDir("", "script")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "inventory.star")
+	if err := ioutil.WriteFile(file, []byte(inventoryScript), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	s := &scripts{maxSteps: 10000}
+	sc, err := s.load(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := sc.indices["inventory"]; !ok || len(sc.apis) != 1 {
+		t.Fatalf("unexpected declarations %+v", sc)
+	}
+	if len(sc.routes) != 1 {
+		t.Fatalf("expected 1 route, got %d", len(sc.routes))
+	}
+	r := sc.routes[0]
+	if r.route.Mainnet == nil || r.route.Mainnet.Min != 2000000 || r.route.Testnet != nil {
+		t.Errorf("unexpected windows %+v %+v", r.route.Mainnet, r.route.Testnet)
+	}
+	payload, ok := r.route.Match.Match(`inv:{"sku":"a1","qty":3}`)
+	if !ok {
+		t.Fatal("route should match")
+	}
+
+	tx := &datastore.TransactionData{Transaction: &flojson.TxRawResult{Txid: "txid"}, Trace: trace.New("txid")}
+	sc.handler(r.fn, s.maxSteps)(payload, tx)
+	if len(tx.Trace.Steps) != 1 || tx.Trace.Steps[0].Kind != trace.KindWrite {
+		t.Fatalf("expected a write, got %+v", tx.Trace.Steps)
+	}
+
+	tx.Trace = trace.New("txid")
+	sc.handler(r.fn, s.maxSteps)(`{"qty":3}`, tx)
+	if len(tx.Trace.Steps) != 1 || tx.Trace.Steps[0].Kind != trace.KindReject {
+		t.Fatalf("expected a rejection, got %+v", tx.Trace.Steps)
+	}
+}
+
+func TestScriptErrors(t *testing.T) {
+	cases := map[string]string{
+		"undeclared": `api("missing")`,
+		"matchers":   `route("r", lambda p, tx: None, prefix = "a", regex = "b")`,
+		"emit":       `emit("inventory", {})`,
+		"steps": `def f():
+    for i in range(100000):
+        pass
+f()`,
+	}
+	for name, src := range cases {
+		dir, err := ioutil.TempDir("", "script")
+		if err != nil {
+			t.Fatal(err)
+		}
+		file := filepath.Join(dir, "bad.star")
+		if err := ioutil.WriteFile(file, []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+		s := &scripts{maxSteps: 10000}
+		if _, err := s.load(file); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+		os.RemoveAll(dir)
+	}
+}
+
+func TestPrepareMaxSteps(t *testing.T) {
+	dir, err := ioutil.TempDir("", "script")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	viper.Set("oip.modules.script.dir", dir)
+
+	cases := map[string]struct {
+		steps    interface{}
+		expected uint64
+	}{
+		"unset": {nil, defaultMaxSteps},
+		"zero":  {0, defaultMaxSteps},
+		"set":   {500, 500},
+	}
+	for name, c := range cases {
+		cfg := viper.New()
+		if c.steps != nil {
+			cfg.Set("maxSteps", c.steps)
+		}
+		s := &scripts{}
+		if err := s.Prepare(cfg); err != nil {
+			t.Fatal(err)
+		}
+		if s.maxSteps != c.expected {
+			t.Errorf("%s: maxSteps %d, want %d", name, s.maxSteps, c.expected)
+		}
+	}
+}
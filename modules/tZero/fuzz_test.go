@@ -0,0 +1,15 @@
+//go:build go1.18
+// +build go1.18
+
+package tZero
+
+import (
+	"testing"
+)
+
+func FuzzParseGeneralInfo(f *testing.F) {
+	f.Add(executionReport)
+	f.Fuzz(func(t *testing.T, s string) {
+		_, _ = parseGeneralInfo(s)
+	})
+}
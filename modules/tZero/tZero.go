@@ -1,118 +1,154 @@
 package tZero
 
 import (
-	"regexp"
+	"context"
+	"errors"
+	"math"
+	"strconv"
 	"strings"
 
+	"github.com/azer/logger"
+	"github.com/spf13/viper"
 	"gopkg.in/olivere/elastic.v6"
 
 	"github.com/oipwg/oip/config"
 	"github.com/oipwg/oip/datastore"
-	"github.com/oipwg/oip/events"
+	"github.com/oipwg/oip/dispatch"
+	"github.com/oipwg/oip/metrics"
+	"github.com/oipwg/oip/modules/module"
+	"github.com/oipwg/oip/rejections"
 )
 
-func init() {
-	log.Info("init tZero")
-	if !config.IsTestnet() {
-		events.SubscribeAsync("flo:floData", floDataProcessor)
-		events.SubscribeAsync("modules:tZero:cancel", onCancel)
-		events.SubscribeAsync("modules:tZero:inventoryPosted", onInventoryPosted)
-		events.SubscribeAsync("modules:tZero:executionReport", onExecutionReport)
-		events.SubscribeAsync("modules:tZero:clientInterest", onClientInterest)
-		datastore.RegisterMapping("tzero", "tZero.json")
-	}
+type tZero struct {
+	hooks module.Hooks
 }
 
-func floDataProcessor(floData string, tx *datastore.TransactionData) {
-	if tx.Block < 2000000 {
-		return
-	}
-
-	if strings.HasPrefix(floData, "Cancel: ") {
-		events.Publish("modules:tZero:cancel", floData, tx)
-		return
-	}
-	if strings.HasPrefix(floData, "Inventory Posted: ") {
-		events.Publish("modules:tZero:inventoryPosted", floData, tx)
-		return
-	}
-	if strings.HasPrefix(floData, "Execution Report: ") {
-		events.Publish("modules:tZero:executionReport", floData, tx)
-		return
-	}
-	if strings.HasPrefix(floData, "Client Interest: ") {
-		events.Publish("modules:tZero:clientInterest", floData, tx)
-		return
-	}
+func init() {
+	module.Register(&tZero{})
 }
 
-func onCancel(floData string, tx *datastore.TransactionData) {
-	gi := extractGeneralInfo(floData)
-
-	gi.Action = "Cancel"
-	bir := elastic.NewBulkIndexRequest().Index(datastore.Index("tzero")).Type("_doc").Id(tx.Transaction.Txid).Doc(gi)
-	datastore.AutoBulk.Add(bir)
+func (t *tZero) Name() string {
+	return "tZero"
 }
 
-func onInventoryPosted(floData string, tx *datastore.TransactionData) {
-	gi := extractGeneralInfo(floData)
+// EnabledByDefault as tZero messages were only published on mainnet
+func (t *tZero) EnabledByDefault() bool {
+	return !config.IsTestnet()
+}
 
-	gi.Action = "InventoryPosted"
-	bir := elastic.NewBulkIndexRequest().Index(datastore.Index("tzero")).Type("_doc").Id(tx.Transaction.Txid).Doc(gi)
-	datastore.AutoBulk.Add(bir)
+func (t *tZero) Mappings() map[string]string {
+	return map[string]string{"tzero": "tZero.json"}
 }
 
-func onClientInterest(floData string, tx *datastore.TransactionData) {
-	gi := extractGeneralInfo(floData)
+func (t *tZero) Routes() {}
 
-	gi.Action = "ClientInterest"
-	bir := elastic.NewBulkIndexRequest().Index(datastore.Index("tzero")).Type("_doc").Id(tx.Transaction.Txid).Doc(gi)
-	datastore.AutoBulk.Add(bir)
+func (t *tZero) Init(ctx context.Context, cfg *viper.Viper) error {
+	log.Info("init tZero")
+	for _, r := range []struct{ name, prefix, action string }{
+		{"cancel", "Cancel: ", "Cancel"},
+		{"inventoryPosted", "Inventory Posted: ", "InventoryPosted"},
+		{"executionReport", "Execution Report: ", "ExecutionReport"},
+		{"clientInterest", "Client Interest: ", "ClientInterest"},
+	} {
+		t.hooks.Subscribe("modules:tZero:"+r.name, onMessage(r.action))
+		t.hooks.Dispatch(dispatch.Route{
+			Name:    "tZero:" + r.name,
+			Topic:   "modules:tZero:" + r.name,
+			Match:   dispatch.Prefix(r.prefix),
+			Mainnet: &dispatch.Window{Min: 2000000},
+		})
+	}
+	return nil
 }
 
-func onExecutionReport(floData string, tx *datastore.TransactionData) {
-	gi := extractGeneralInfo(floData)
-
-	gi.Action = "ExecutionReport"
-	bir := elastic.NewBulkIndexRequest().Index(datastore.Index("tzero")).Type("_doc").Id(tx.Transaction.Txid).Doc(gi)
-	datastore.AutoBulk.Add(bir)
+func (t *tZero) Shutdown(ctx context.Context) error {
+	t.hooks.Remove()
+	return nil
 }
 
-var giRe = regexp.MustCompile(`([A-Za-z]+)\((.*?)\)`)
+// onMessage returns the handler indexing tZero messages as action
+func onMessage(action string) func(floData string, tx *datastore.TransactionData) {
+	return func(floData string, tx *datastore.TransactionData) {
+		gi, err := parseGeneralInfo(floData)
+		if err != nil {
+			log.Error("unable to parse tZero message", logger.Attrs{"txid": tx.Transaction.Txid, "action": action, "err": err})
+			rejections.Reject(tx, "tZero", rejections.StageDecode, "malformed tZero message", err)
+			return
+		}
 
-func extractGeneralInfo(s string) tZeroTransaction {
-	matches := giRe.FindAllStringSubmatch(s, -1)
+		gi.Action = action
+		bir := elastic.NewBulkIndexRequest().Index(datastore.Index("tzero")).Type("_doc").Id(tx.Transaction.Txid).Doc(gi)
+		datastore.AutoBulk.AddFor(tx, bir)
+		metrics.Accepted("tZero")
+	}
+}
 
+// parseGeneralInfo reads the Key(value) fields of a tZero message, fields the
+// index maps as numbers must parse as such or the whole message is rejected
+func parseGeneralInfo(s string) (tZeroTransaction, error) {
 	tgi := tZeroTransaction{}
-	for _, value := range matches {
-		if len(value) == 3 {
-			switch value[1] {
-			case "SOI":
-				tgi.SOI = value[2]
-			case "STI":
-				tgi.STI = value[2]
-			case "Broker":
-				tgi.Broker = value[2]
-			case "Account":
-				tgi.Account = value[2]
-			case "Time":
-				tgi.Time = value[2]
-			case "Side":
-				tgi.Side = value[2]
-			case "Symbol":
-				tgi.Symbol = value[2]
-			case "Qty":
-				tgi.Qty = value[2]
-			case "Price":
-				tgi.Price = value[2]
-			case "OrderType":
-				tgi.OrderType = value[2]
-			case "TimeInForce":
-				tgi.TimeInForce = value[2]
+	fields := 0
+	for {
+		open := strings.IndexByte(s, '(')
+		if open < 0 {
+			break
+		}
+		key := s[:open]
+		for i := len(key) - 1; i >= 0; i-- {
+			c := key[i]
+			if (c < 'A' || c > 'Z') && (c < 'a' || c > 'z') {
+				key = key[i+1:]
+				break
+			}
+		}
+		end := strings.IndexByte(s[open+1:], ')')
+		if end < 0 {
+			return tgi, errors.New("unterminated field " + key)
+		}
+		value := s[open+1 : open+1+end]
+		s = s[open+1+end+1:]
+
+		var dest *string
+		numeric := false
+		switch key {
+		case "SOI":
+			dest, numeric = &tgi.SOI, true
+		case "STI":
+			dest, numeric = &tgi.STI, true
+		case "Broker":
+			dest, numeric = &tgi.Broker, true
+		case "Account":
+			dest = &tgi.Account
+		case "Time":
+			dest = &tgi.Time
+		case "Side":
+			dest = &tgi.Side
+		case "Symbol":
+			dest = &tgi.Symbol
+		case "Qty":
+			dest, numeric = &tgi.Qty, true
+		case "Price":
+			dest, numeric = &tgi.Price, true
+		case "OrderType":
+			dest = &tgi.OrderType
+		case "TimeInForce":
+			dest = &tgi.TimeInForce
+		default:
+			continue
+		}
+		if numeric && value != "" {
+			f, err := strconv.ParseFloat(value, 64)
+			if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+				return tgi, errors.New("non-numeric " + key)
 			}
 		}
+		*dest = value
+		fields++
+	}
+	if fields == 0 {
+		return tgi, errors.New("no fields found")
 	}
-	return tgi
+	return tgi, nil
 }
 
 type tZeroTransaction struct {
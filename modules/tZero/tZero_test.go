@@ -0,0 +1,42 @@
+package tZero
+
+import (
+	"testing"
+)
+
+const executionReport = "Execution Report: SOI(3) STI(7) Broker(1012) Account(TZP123) Time(04/26/2018 14:27:35.123) Side(Buy) Symbol(TZROP) Qty(100) Price(10.25) OrderType(Limit) TimeInForce(Day)"
+
+func TestParseGeneralInfo(t *testing.T) {
+	gi, err := parseGeneralInfo(executionReport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := tZeroTransaction{
+		SOI:         "3",
+		STI:         "7",
+		Broker:      "1012",
+		Account:     "TZP123",
+		Time:        "04/26/2018 14:27:35.123",
+		Side:        "Buy",
+		Symbol:      "TZROP",
+		Qty:         "100",
+		Price:       "10.25",
+		OrderType:   "Limit",
+		TimeInForce: "Day",
+	}
+	if gi != want {
+		t.Errorf("got %+v want %+v", gi, want)
+	}
+
+	for _, s := range []string{
+		"Cancel: ",
+		"Cancel: SOI(3",
+		"Cancel: SOI(three)",
+		"Cancel: Price(NaN)",
+		"Cancel: Unknown(1)",
+	} {
+		if _, err := parseGeneralInfo(s); err == nil {
+			t.Errorf("expected error for %q", s)
+		}
+	}
+}
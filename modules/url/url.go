@@ -1,24 +1,56 @@
 package url
 
 import (
-	"strings"
+	"context"
+	"regexp"
+
+	"github.com/spf13/viper"
 
 	"github.com/oipwg/oip/datastore"
-	"github.com/oipwg/oip/events"
+	"github.com/oipwg/oip/dispatch"
+	"github.com/oipwg/oip/modules/module"
 )
 
+type url struct {
+	hooks module.Hooks
+}
+
 func init() {
-	events.SubscribeAsync("flo:floData", onFloData)
-	events.SubscribeAsync("modules:url", onUrl)
+	module.Register(&url{})
+}
+
+func (u *url) Name() string {
+	return "url"
+}
+
+// EnabledByDefault as url messages are not yet processed
+func (u *url) EnabledByDefault() bool {
+	return false
+}
+
+func (u *url) Mappings() map[string]string {
+	return nil
+}
+
+func (u *url) Routes() {}
+
+func (u *url) Init(ctx context.Context, cfg *viper.Viper) error {
+	u.hooks.Subscribe("modules:url", onUrl)
+	u.hooks.Dispatch(dispatch.Route{
+		Name:    "url",
+		Topic:   "modules:url",
+		Match:   dispatch.Regex(regexp.MustCompile(`^https?://`)),
+		Mainnet: &dispatch.Window{},
+		Testnet: &dispatch.Window{},
+	})
+	return nil
 }
 
-func onFloData(floData string, tx *datastore.TransactionData) {
-	if strings.HasPrefix(floData, "http://") || strings.HasPrefix(floData, "https://") {
-		events.Publish("modules:url", floData)
-		return
-	}
+func (u *url) Shutdown(ctx context.Context) error {
+	u.hooks.Remove()
+	return nil
 }
 
-func onUrl(floData string) {
+func onUrl(floData string, tx *datastore.TransactionData) {
 
 }
@@ -102,6 +102,15 @@ buildIt:
 	return mp.part0Tx, serParts, nil
 }
 
+// PartCount estimates the number of transactions needed to publish floData of
+// length dataLen, data longer than MaxFloDataLen is split into a multipart
+func PartCount(dataLen int) int {
+	if dataLen <= MaxFloDataLen {
+		return 1
+	}
+	return basePartCount(dataLen)
+}
+
 func basePartCount(dataLen int) int {
 	return (dataLen-baseDataSize0)/baseDataSizeX + 2
 }
@@ -9,29 +9,39 @@ import (
 	"github.com/bitspill/flod/rpcclient"
 	"github.com/bitspill/floutil"
 	"github.com/davecgh/go-spew/spew"
+
+	"github.com/oipwg/oip/flo/flotest"
 )
 
 var floAddress = "FUE5a3b45n9Jfr5apoq7VnsPxMkTVBnLJQ"
 var floWifKey = "RBmWKRJpujYmRsRkGBx4AY2rL1GkiDdMfBv52625CzZBa7Ni4Peu"
 
 func TestRPC(t *testing.T) {
+	s := flotest.NewServer(flotest.NewChain(&chaincfg.MainNetParams))
+	defer s.Close()
+
 	cfg := &rpcclient.ConnConfig{
-		Host:         "127.0.0.1:8334",
+		Host:         s.Host(),
 		Endpoint:     "ws",
-		User:         "user",
-		Pass:         "pass",
+		User:         flotest.User,
+		Pass:         flotest.Pass,
 		DisableTLS:   true,
 		Certificates: nil,
 	}
 	client, err := rpcclient.New(cfg, nil)
 	if err != nil {
-		t.Skip("flod not available")
+		t.Fatal(err)
 	}
+	defer client.Shutdown()
 
 	addr, err := floutil.DecodeAddress(floAddress, &chaincfg.MainNetParams)
 	if err != nil {
 		t.Fatal(err)
 	}
+	if _, err := s.Chain.Fund(addr, 10*floutil.SatoshiPerBitcoin); err != nil {
+		t.Fatal(err)
+	}
+	s.Chain.Mine()
 	wif, err := floutil.DecodeWIF(floWifKey)
 	if err != nil {
 		t.Fatal(err)
@@ -57,6 +67,16 @@ func TestRPC(t *testing.T) {
 
 	fmt.Println("total", time.Since(start))
 
+	b := s.Chain.Mine()
+	if len(b.Txs) != len(res.TxHash)+1 {
+		t.Fatalf("mined %d transactions, sent %d", len(b.Txs)-1, len(res.TxHash))
+	}
+	for i, h := range res.TxHash {
+		if b.Txs[i+1].Hash != *h {
+			t.Errorf("part %d not mined in order", i)
+		}
+	}
+
 }
 
 var randomText = `Talent she for lively eat led sister. Entrance strongly packages she out rendered get quitting denoting led. Dwelling confined improved it he no doubtful raptures. Several carried through an of up attempt gravity. Situation to be at offending elsewhere distrusts if. Particular use for considered projection cultivated. Worth of do doubt shall it their. Extensive existence up me contained he pronounce do. Excellence inquietude assistance precaution any impression man sufficient. 
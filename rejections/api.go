@@ -0,0 +1,104 @@
+package rejections
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
+	"gopkg.in/olivere/elastic.v6"
+
+	"github.com/oipwg/oip/httpapi"
+)
+
+var rejectionsRouter = httpapi.NewSubRoute("/rejections")
+
+func init() {
+	rejectionsRouter.HandleFunc("/get/latest", handleLatest, httpapi.RouteDoc{
+		Summary:  "Latest rejected messages",
+		Paged:    true,
+		Response: httpapi.SearchResponse(httpapi.SchemaOf(Rejection{})),
+	})
+	rejectionsRouter.HandleFunc("/get/{txid:[a-f0-9]+}", handleGet, httpapi.RouteDoc{
+		Summary:     "Rejections of a transaction by txid or txid prefix",
+		Description: "A transaction may be rejected by several modules or at several stages.",
+		Paged:       true,
+		Response:    httpapi.SearchResponse(httpapi.SchemaOf(Rejection{})),
+	})
+	rejectionsRouter.HandleFunc("/search", handleSearch, httpapi.RouteDoc{
+		Summary:  "Search rejections with an Elasticsearch query string",
+		Paged:    true,
+		Response: httpapi.SearchResponse(httpapi.SchemaOf(Rejection{})),
+	}).Queries("q", "{query}")
+	rejectionsRouter.HandleFunc("/search", rejectionSearch.HandleSearch,
+		rejectionSearch.SearchDoc("Search rejections with a json search query")).Methods("POST")
+	rejectionsRouter.HandleFunc("/facets", rejectionSearch.HandleFacets,
+		rejectionSearch.FacetsDoc("Facets over rejections")).Methods("POST")
+}
+
+var (
+	rejectionSorts = []elastic.SortInfo{
+		{Field: "time", Ascending: false},
+		{Field: "txid", Ascending: true},
+	}
+	rejectionSearch = &httpapi.SearchResource{
+		Indices: []string{indexName},
+		Fields: httpapi.SearchFields{
+			"txid":           httpapi.KeywordField,
+			"module":         httpapi.KeywordField,
+			"stage":          httpapi.KeywordField,
+			"reason":         httpapi.TextField,
+			"reason.keyword": httpapi.KeywordField,
+			"error":          httpapi.TextField,
+			"floData":        httpapi.TextField,
+			"block":          httpapi.NumericField,
+			"block_hash":     httpapi.KeywordField,
+			"time":           httpapi.DateField,
+		},
+		Sorts: rejectionSorts,
+	}
+)
+
+func handleLatest(w http.ResponseWriter, r *http.Request) {
+	searchService := httpapi.BuildCommonSearchService(
+		r.Context(),
+		[]string{indexName},
+		elastic.NewMatchAllQuery(),
+		rejectionSorts,
+		nil,
+	)
+	httpapi.RespondSearch(r.Context(), w, searchService)
+}
+
+func handleGet(w http.ResponseWriter, r *http.Request) {
+	var opts = mux.Vars(r)
+
+	searchService := httpapi.BuildCommonSearchService(
+		r.Context(),
+		[]string{indexName},
+		elastic.NewPrefixQuery("txid", opts["txid"]),
+		rejectionSorts,
+		nil,
+	)
+	httpapi.RespondSearch(r.Context(), w, searchService)
+}
+
+func handleSearch(w http.ResponseWriter, r *http.Request) {
+	var opts = mux.Vars(r)
+
+	searchQuery, err := url.PathUnescape(opts["query"])
+	if err != nil {
+		httpapi.RespondJSON(r.Context(), w, 400, map[string]interface{}{
+			"error": "unable to decode query",
+		})
+		return
+	}
+
+	searchService := httpapi.BuildCommonSearchService(
+		r.Context(),
+		[]string{indexName},
+		elastic.NewQueryStringQuery(searchQuery).AnalyzeWildcard(false),
+		rejectionSorts,
+		nil,
+	)
+	httpapi.RespondSearch(r.Context(), w, searchService)
+}
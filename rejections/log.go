@@ -0,0 +1,5 @@
+package rejections
+
+import "github.com/azer/logger"
+
+var log = logger.New("rejections")
@@ -0,0 +1,102 @@
+package rejections
+
+import (
+	"unicode/utf8"
+
+	"github.com/azer/logger"
+	"gopkg.in/olivere/elastic.v6"
+
+	"github.com/oipwg/oip/datastore"
+	"github.com/oipwg/oip/metrics"
+	"github.com/oipwg/oip/trace"
+)
+
+const indexName = "rejections"
+
+// maximum bytes of floData retained with a rejection
+const floDataExcerpt = 512
+
+// Stages at which a message may be rejected
+const (
+	StageDecode    = "decode"
+	StageUnmarshal = "unmarshal"
+	StageSignature = "signature"
+	StageValidate  = "validate"
+	StageRoute     = "route"
+)
+
+// Rejection records why a module dropped a message
+type Rejection struct {
+	Txid      string `json:"txid"`
+	Module    string `json:"module"`
+	Stage     string `json:"stage"`
+	Reason    string `json:"reason"`
+	Error     string `json:"error,omitempty"`
+	FloData   string `json:"floData"`
+	Block     int64  `json:"block"`
+	BlockHash string `json:"block_hash"`
+	Time      int64  `json:"time"`
+}
+
+func init() {
+	datastore.RegisterMapping(indexName, "rejections.json")
+}
+
+// Reject stores the reason module dropped the message of tx and counts it as rejected
+// err, if non-nil, is stored alongside the reason as additional detail
+// Rejections of a traced transaction are only recorded on the trace
+func Reject(tx *datastore.TransactionData, module, stage, reason string, err error) {
+	if t := tx.GetTrace(); t != nil {
+		step := &trace.Step{Kind: trace.KindReject, Module: module, Stage: stage, Detail: reason}
+		if err != nil {
+			step.Error = err.Error()
+		}
+		t.Add(step)
+		return
+	}
+
+	metrics.Rejected(module)
+	if tx == nil || tx.Transaction == nil {
+		log.Error("rejection without transaction", logger.Attrs{"module": module, "stage": stage, "reason": reason})
+		return
+	}
+
+	rej := Rejection{
+		Txid:      tx.Transaction.Txid,
+		Module:    module,
+		Stage:     stage,
+		Reason:    reason,
+		FloData:   excerpt(tx.Transaction.FloData),
+		Block:     tx.Block,
+		BlockHash: tx.BlockHash,
+		Time:      tx.Transaction.Time,
+	}
+	if err != nil {
+		rej.Error = err.Error()
+	}
+
+	// one rejection per module and stage, re-processing a transaction replaces it
+	bir := elastic.NewBulkIndexRequest().
+		Index(datastore.Index(indexName)).
+		Type("_doc").
+		Id(rej.Txid + "_" + module + "_" + stage).
+		Doc(rej)
+	datastore.AutoBulk.Add(bir)
+}
+
+func excerpt(floData string) string {
+	if len(floData) <= floDataExcerpt {
+		return floData
+	}
+	e := floData[:floDataExcerpt]
+	// back off a multi-byte rune split by the cut, invalid bytes before it are kept
+	for i := len(e) - 1; i >= 0 && len(e)-i < utf8.UTFMax; i-- {
+		if utf8.RuneStart(e[i]) {
+			if _, size := utf8.DecodeRuneInString(floData[i:]); i+size > len(e) {
+				e = e[:i]
+			}
+			break
+		}
+	}
+	return e
+}
@@ -0,0 +1,29 @@
+package rejections
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestExcerpt(t *testing.T) {
+	if e := excerpt("short"); e != "short" {
+		t.Errorf("unexpected excerpt %q", e)
+	}
+
+	long := strings.Repeat("a", floDataExcerpt-1) + "é" + "tail"
+	e := excerpt(long)
+	if len(e) != floDataExcerpt-1 || !utf8.ValidString(e) {
+		t.Errorf("expected split rune to be dropped, got length %d", len(e))
+	}
+
+	// binary floData is kept up to the cut
+	binary := "\xff" + strings.Repeat("a", floDataExcerpt)
+	if e := excerpt(binary); e != binary[:floDataExcerpt] {
+		t.Errorf("expected invalid bytes to be kept, got length %d", len(e))
+	}
+	invalidEnd := strings.Repeat("a", floDataExcerpt-1) + "\xe9tail"
+	if e := excerpt(invalidEnd); e != invalidEnd[:floDataExcerpt] {
+		t.Errorf("expected invalid final byte to be kept, got length %d", len(e))
+	}
+}
@@ -12,7 +12,20 @@ import (
 var syncRouter = httpapi.NewSubRoute("/sync")
 
 func init() {
-	syncRouter.HandleFunc("/status", HandleStatus)
+	syncRouter.HandleFunc("/status", HandleStatus, httpapi.RouteDoc{
+		Summary:     "Progress of the blockchain sync",
+		Description: "Responds 503 if flod is unreachable.",
+		Cache:       httpapi.CacheNone,
+		Response: httpapi.ObjectSchema(map[string]*httpapi.Schema{
+			"IsInitialSync":         {Type: "boolean"},
+			"MultipartSyncComplete": {Type: "boolean"},
+			"EditSyncComplete":      {Type: "boolean"},
+			"Height":                {Type: "integer"},
+			"Timestamp":             {Type: "integer"},
+			"LatestHeight":          {Type: "integer"},
+			"Progress":              {Type: "number"},
+		}),
+	})
 }
 
 func HandleStatus(w http.ResponseWriter, r *http.Request) {
@@ -21,6 +34,10 @@ func HandleStatus(w http.ResponseWriter, r *http.Request) {
 	count, err := flo.GetBlockCount()
 	if err != nil {
 		log.Error("/sync/status GetBlockCount failed", logger.Attrs{"err": err})
+		httpapi.RespondJSON(r.Context(), w, http.StatusServiceUnavailable, map[string]interface{}{
+			"error": "unable to reach flod",
+		})
+		return
 	}
 
 	height := int64(0)
@@ -32,9 +49,9 @@ func HandleStatus(w http.ResponseWriter, r *http.Request) {
 	}
 
 	httpapi.RespondJSON(r.Context(), w, http.StatusOK, map[string]interface{}{
-		"IsInitialSync":         IsInitialSync,
-		"MultipartSyncComplete": MultipartSyncComplete,
-		"EditSyncComplete":      EditSyncComplete,
+		"IsInitialSync":         IsInitialSync.IsSet(),
+		"MultipartSyncComplete": MultipartSyncComplete.IsSet(),
+		"EditSyncComplete":      EditSyncComplete.IsSet(),
 		"Height":                height,
 		"Timestamp":             time,
 		"LatestHeight":          count,
@@ -1,6 +1,9 @@
 package sync
 
 import (
+	"sync/atomic"
+	"time"
+
 	"github.com/bitspill/flod/chaincfg/chainhash"
 	"github.com/bitspill/flod/flojson"
 	"github.com/bitspill/floutil"
@@ -8,21 +11,40 @@ import (
 	"github.com/oipwg/oip/datastore"
 	"github.com/oipwg/oip/events"
 	"github.com/oipwg/oip/flo"
+	"github.com/oipwg/oip/httpapi"
 )
 
 var (
-	IsInitialSync = true
-	MultipartSyncComplete = false
-	EditSyncComplete = false
-	recentBlocks  = blockBuffer{}
+	IsInitialSync         = Flag{1}
+	MultipartSyncComplete Flag
+	EditSyncComplete      Flag
+	recentBlocks          = blockBuffer{}
 )
 
+// Flag is a sync state set by the sync and read concurrently by the api and health checks
+type Flag struct {
+	v int32
+}
+
+func (f *Flag) Set(b bool) {
+	var v int32
+	if b {
+		v = 1
+	}
+	atomic.StoreInt32(&f.v, v)
+}
+
+func (f *Flag) IsSet() bool {
+	return atomic.LoadInt32(&f.v) == 1
+}
+
 func Setup() {
 	// ToDo: refresh_interval
 	//  https://www.elastic.co/guide/en/elasticsearch/reference/current/tune-for-indexing-speed.html#_increase_the_refresh_interval
 }
 
 func IndexBlockAtHeight(height int64, lb datastore.BlockData) (datastore.BlockData, error) {
+	start := time.Now()
 	hash, err := flo.GetBlockHash(height)
 	if err != nil {
 		return lb, err
@@ -69,6 +91,9 @@ func IndexBlockAtHeight(height int64, lb datastore.BlockData) (datastore.BlockDa
 		}
 	}
 	recentBlocks.Push(&bd)
+	httpapi.SetChainHeight(bd.Block.Height)
+	setSyncHeight(bd.Block.Height)
+	blockDuration.Observe(time.Since(start).Seconds())
 	return bd, nil
 }
 
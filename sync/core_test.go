@@ -0,0 +1,62 @@
+package sync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bitspill/flod/chaincfg"
+
+	"github.com/oipwg/oip/datastore"
+	"github.com/oipwg/oip/events"
+	"github.com/oipwg/oip/flo"
+	"github.com/oipwg/oip/flo/flotest"
+)
+
+func TestIndexBlockAtHeight(t *testing.T) {
+	s := flotest.NewServer(flotest.NewChain(&chaincfg.MainNetParams))
+	defer s.Close()
+	if err := flo.AddFlod(s.Host(), flotest.User, flotest.Pass, false); err != nil {
+		t.Fatal(err)
+	}
+	defer flo.Disconnect()
+	datastore.AutoBulk = datastore.BeginBulkIndexer()
+
+	floData := make(chan string, 8)
+	onFloData := func(fd string, tx *datastore.TransactionData) {
+		floData <- fd
+	}
+	events.SubscribeAsync("flo:floData", onFloData)
+	defer events.Unsubscribe("flo:floData", onFloData)
+
+	s.Chain.Send("first")
+	s.Chain.Mine("second")
+	s.Chain.Mine()
+
+	lb, err := IndexBlockAtHeight(1, datastore.BlockData{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lb.Block.Hash != s.Chain.BlockAt(1).Hash.String() || len(lb.Block.RawTx) != 3 {
+		t.Errorf("unexpected block %s with %d transactions", lb.Block.Hash, len(lb.Block.RawTx))
+	}
+	lb, err = IndexBlockAtHeight(2, lb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lb.SecSinceLastBlock != 40 {
+		t.Errorf("%d seconds since last block, want 40", lb.SecSinceLastBlock)
+	}
+
+	got := map[string]bool{}
+	for len(got) < 2 {
+		select {
+		case fd := <-floData:
+			got[fd] = true
+		case <-time.After(5 * time.Second):
+			t.Fatalf("floData not published, got %v", got)
+		}
+	}
+	if !got["first"] || !got["second"] {
+		t.Errorf("unexpected floData %v", got)
+	}
+}
@@ -0,0 +1,50 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+
+	"github.com/oipwg/oip/flo"
+	"github.com/oipwg/oip/health"
+)
+
+func init() {
+	health.Register("syncLag", checkSyncLag)
+	health.Register("multipartSync", checkMultipartSync)
+	health.Register("editSync", checkEditSync)
+}
+
+// checkSyncLag verifies the indexed height is within oip.api.health.maxSyncLag blocks of the tip,
+// the tip is asked of flod as block notifications may have stopped
+func checkSyncLag(ctx context.Context) (string, error) {
+	if IsInitialSync.IsSet() {
+		return "", errors.New("initial sync in progress")
+	}
+	count, err := flo.GetBlockCount()
+	if err != nil {
+		return "", errors.Wrap(err, "unable to get block count")
+	}
+	setChainTip(count)
+	lag := syncLag()
+	if max := viper.GetInt64("oip.api.health.maxSyncLag"); lag > max {
+		return "", fmt.Errorf("%d blocks behind the tip, exceeds %d", lag, max)
+	}
+	return fmt.Sprintf("%d blocks behind the tip", lag), nil
+}
+
+func checkMultipartSync(ctx context.Context) (string, error) {
+	if !MultipartSyncComplete.IsSet() {
+		return "", errors.New("multipart sync incomplete")
+	}
+	return "complete", nil
+}
+
+func checkEditSync(ctx context.Context) (string, error) {
+	if !EditSyncComplete.IsSet() {
+		return "", errors.New("edit sync incomplete")
+	}
+	return "complete", nil
+}
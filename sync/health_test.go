@@ -0,0 +1,38 @@
+package sync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bitspill/flod/chaincfg"
+	"github.com/spf13/viper"
+
+	"github.com/oipwg/oip/flo"
+	"github.com/oipwg/oip/flo/flotest"
+)
+
+func TestCheckSyncLag(t *testing.T) {
+	s := flotest.NewServer(flotest.NewChain(&chaincfg.MainNetParams))
+	defer s.Close()
+	if err := flo.AddFlod(s.Host(), flotest.User, flotest.Pass, false); err != nil {
+		t.Fatal(err)
+	}
+	defer flo.Disconnect()
+	defer IsInitialSync.Set(IsInitialSync.IsSet())
+	IsInitialSync.Set(false)
+	viper.Set("oip.api.health.maxSyncLag", 2)
+
+	s.Chain.Mine()
+	setSyncHeight(s.Chain.Height())
+	if _, err := checkSyncLag(context.Background()); err != nil {
+		t.Errorf("synced to the tip: %v", err)
+	}
+
+	// blocks mined without notifying the sync
+	s.Chain.Mine()
+	s.Chain.Mine()
+	s.Chain.Mine()
+	if _, err := checkSyncLag(context.Background()); err == nil {
+		t.Errorf("no error %d blocks behind the tip", syncLag())
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/oipwg/oip/datastore"
 	"github.com/oipwg/oip/flo"
+	"github.com/oipwg/oip/httpapi"
 )
 
 func InitialSync(ctx context.Context, count int64) (datastore.BlockData, error) {
@@ -27,6 +28,8 @@ func InitialSync(ctx context.Context, count int64) (datastore.BlockData, error)
 
 	if lb.Block != nil {
 		lbh = lb.Block.Height
+		httpapi.SetChainHeight(lbh)
+		setSyncHeight(lbh)
 
 		hash, err := flo.GetBlockHash(lb.Block.Height)
 		if err != nil {
@@ -45,6 +48,7 @@ func InitialSync(ctx context.Context, count int64) (datastore.BlockData, error)
 		}
 	}
 
+	setChainTip(count)
 	startup := time.Now()
 	totalEstimatedSize := int64(0)
 
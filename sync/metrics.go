@@ -0,0 +1,72 @@
+package sync
+
+import (
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/oipwg/oip/metrics"
+)
+
+var (
+	syncHeight int64
+	chainTip   int64
+)
+
+var (
+	_ = promauto.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "sync",
+		Name:      "height",
+		Help:      "Height of the last indexed block",
+	}, func() float64 {
+		return float64(atomic.LoadInt64(&syncHeight))
+	})
+	_ = promauto.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "sync",
+		Name:      "chain_tip",
+		Help:      "Height of the chain tip as reported by flod",
+	}, func() float64 {
+		return float64(atomic.LoadInt64(&chainTip))
+	})
+	_ = promauto.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "sync",
+		Name:      "lag_blocks",
+		Help:      "Blocks between the chain tip and the last indexed block",
+	}, func() float64 {
+		return float64(syncLag())
+	})
+	blockDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "sync",
+		Name:      "block_duration_seconds",
+		Help:      "Time taken to fetch and process a block",
+		Buckets:   prometheus.ExponentialBuckets(0.005, 3, 10),
+	})
+)
+
+func setSyncHeight(height int64) {
+	atomic.StoreInt64(&syncHeight, height)
+	setChainTip(height)
+}
+
+// setChainTip raises the known chain tip, reorgs are handled by setSyncHeight
+func setChainTip(height int64) {
+	for {
+		tip := atomic.LoadInt64(&chainTip)
+		if height <= tip || atomic.CompareAndSwapInt64(&chainTip, tip, height) {
+			return
+		}
+	}
+}
+
+func syncLag() int64 {
+	lag := atomic.LoadInt64(&chainTip) - atomic.LoadInt64(&syncHeight)
+	if lag < 0 {
+		return 0
+	}
+	return lag
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/oipwg/oip/datastore"
 	"github.com/oipwg/oip/events"
+	"github.com/oipwg/oip/httpapi"
 )
 
 func init() {
@@ -33,6 +34,7 @@ func onFilteredBlockConnected(height int32, header *wire.BlockHeader, txns []*fl
 		defer onBlockConnectMutex.Unlock()
 	}
 
+	setChainTip(int64(height))
 	headerHash := header.BlockHash().String()
 
 	attr := logger.Attrs{"iHeight": height, "iHash": headerHash}
@@ -133,6 +135,9 @@ func onFilteredBlockDisconnected(height int32, header *wire.BlockHeader) {
 
 	// Mark the blocks as orphaned and send off the update requests
 	datastore.AutoBulk.OrphanBlock(header.BlockHash().String())
+	httpapi.SetChainHeight(int64(height) - 1)
+	atomic.StoreInt64(&syncHeight, int64(height)-1)
+	atomic.StoreInt64(&chainTip, int64(height)-1)
 	// todo: orphan transactions, artifacts, multiparts, etc
 	log.Info("Marked Block as Orphaned: %v (%d) %v", header.BlockHash().String(), height, header.Timestamp)
 
@@ -0,0 +1,103 @@
+package trace
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Kinds of steps recorded on a trace
+const (
+	// an event was published, children are the handlers executed
+	KindPublish = "publish"
+	// a handler was executed, children are the steps it took
+	KindHandler = "handler"
+	// a decision taken by a handler
+	KindNote = "note"
+	// a datastore request which would have been executed
+	KindWrite = "write"
+	// the message was rejected
+	KindReject = "reject"
+	// a handler panicked
+	KindPanic = "panic"
+)
+
+// Trace records the decision tree of a transaction re-processed in dry-run mode
+// All methods are safe to call on a nil *Trace, allowing callers to record steps unconditionally
+type Trace struct {
+	Txid  string  `json:"txid"`
+	Steps []*Step `json:"steps"`
+
+	mu    sync.Mutex
+	stack []*Step
+}
+
+// Step is a single node of the decision tree
+type Step struct {
+	Kind    string      `json:"kind"`
+	Topic   string      `json:"topic,omitempty"`
+	Handler string      `json:"handler,omitempty"`
+	Module  string      `json:"module,omitempty"`
+	Stage   string      `json:"stage,omitempty"`
+	Detail  string      `json:"detail,omitempty"`
+	Error   string      `json:"error,omitempty"`
+	Action  string      `json:"action,omitempty"`
+	Index   string      `json:"index,omitempty"`
+	Id      string      `json:"id,omitempty"`
+	Doc     interface{} `json:"doc,omitempty"`
+	Steps   []*Step     `json:"steps,omitempty"`
+}
+
+// New creates an empty trace of txid
+func New(txid string) *Trace {
+	return &Trace{Txid: txid, Steps: []*Step{}}
+}
+
+// Add appends a step to the current node
+func (t *Trace) Add(s *Step) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.add(s)
+}
+
+func (t *Trace) add(s *Step) {
+	if len(t.stack) == 0 {
+		t.Steps = append(t.Steps, s)
+		return
+	}
+	parent := t.stack[len(t.stack)-1]
+	parent.Steps = append(parent.Steps, s)
+}
+
+// Begin appends a step to the current node and makes it the current node until End is called
+func (t *Trace) Begin(s *Step) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.add(s)
+	t.stack = append(t.stack, s)
+}
+
+// End returns to the parent of the current node
+func (t *Trace) End() {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.stack) > 0 {
+		t.stack = t.stack[:len(t.stack)-1]
+	}
+}
+
+// Note records a decision taken by the current handler
+func (t *Trace) Note(format string, args ...interface{}) {
+	if t == nil {
+		return
+	}
+	t.Add(&Step{Kind: KindNote, Detail: fmt.Sprintf(format, args...)})
+}